@@ -56,13 +56,17 @@ func main() {
 	}
 	log.Println("Connected to Payment Service at", cfg.PaymentSvcUrl)
 
+	verifier := middleware.NewTokenVerifier(authClient, rdb)
+	auditor := middleware.NewImpersonationAuditor(authClient)
+
 	// 3. Инициализация хендлеров
 	authHandler := handlers.NewAuthHandler(authClient)
 	userHandler := handlers.NewUserHandler(userClient, authClient)
 	courseHandler := handlers.NewCourseHandler(courseClient, userClient)
 	paymentHandler := handlers.NewPaymentHandler(paymentClient)
+	adminHandler := handlers.NewAdminHandler(authClient, paymentClient)
 	// 4. Роутер
-	router := handlers.NewRouter(authHandler, userHandler, rateLimiter, authClient, courseHandler, paymentHandler)
+	router := handlers.NewRouter(authHandler, userHandler, rateLimiter, verifier, auditor, courseHandler, paymentHandler, adminHandler)
 
 	// 5. Запуск HTTP сервера
 	log.Printf("API Gateway running on port %s", cfg.Port)
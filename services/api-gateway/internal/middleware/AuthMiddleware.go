@@ -4,14 +4,21 @@ import (
 	"net/http"
 	"strings"
 
-	"api-gateway/internal/client"
-	authpb "api-gateway/pkg/authpb/proto/auth"
-
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-func AuthMiddleware(authClient *client.AuthClient) gin.HandlerFunc {
+// AuthMiddleware проверяет access-токен локально, без gRPC-вызова Validate на каждый запрос.
+// Запросы с токеном имперсонации сначала пишутся в журнал через auditor.
+// Боты и интеграции вместо токена передают персональный ключ в X-API-Key.
+func AuthMiddleware(verifier *TokenVerifier, auditor *ImpersonationAuditor) gin.HandlerFunc {
 	return func(c *gin.Context) {
+		if apiKey := c.GetHeader("X-API-Key"); apiKey != "" {
+			authenticateAPIKey(c, verifier, apiKey)
+			return
+		}
+
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authorization header is required"})
@@ -26,17 +33,113 @@ func AuthMiddleware(authClient *client.AuthClient) gin.HandlerFunc {
 
 		accessToken := parts[1]
 
-		res, err := authClient.Client.Validate(c, &authpb.ValidateRequest{
-			AccessToken: accessToken,
-		})
-
+		claims, err := verifier.Verify(c, accessToken)
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired token"})
 			return
 		}
 
-		c.Set("userId", res.UserId)
+		c.Set("userId", claims.UserID)
+		c.Set("deviceId", claims.DeviceID)
+		c.Set("mfa", claims.MFA)
+		c.Set("roles", claims.Roles)
+		c.Set("permissions", claims.Permissions)
+		c.Set("actorId", claims.ActorID)
+
+		if claims.ActorID != "" {
+			// Без записи в журнале запрос от чужого имени не выполняем
+			if err := auditor.Record(c, claims); err != nil {
+				c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "Audit log unavailable"})
+				return
+			}
+			c.Header("X-Impersonated-By", claims.ActorID)
+		}
 
 		c.Next()
 	}
 }
+
+func authenticateAPIKey(c *gin.Context, verifier *TokenVerifier, apiKey string) {
+	claims, err := verifier.VerifyAPIKey(c, apiKey, c.ClientIP())
+	if err != nil {
+		st, _ := status.FromError(err)
+		switch st.Code() {
+		case codes.Unauthenticated:
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired API key"})
+		case codes.PermissionDenied:
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": st.Message(), "code": "account_banned"})
+		default:
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "Auth service unavailable"})
+		}
+		return
+	}
+
+	// Чтение — scope read, все остальное — scope write
+	scope := ScopeWrite
+	if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+		scope = ScopeRead
+	}
+	if !hasScope(claims.Scopes, scope) {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+			"error": "API key lacks scope " + scope,
+			"code":  "scope_required",
+		})
+		return
+	}
+
+	c.Set("userId", claims.UserID)
+	c.Set("roles", claims.Roles)
+	c.Set("permissions", claims.Permissions)
+	c.Set("apiKeyId", claims.APIKeyID)
+	c.Set("scopes", claims.Scopes)
+	c.Next()
+}
+
+func hasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// RequireMFA пускает только сессии, подтвержденные вторым фактором.
+// Ставится после AuthMiddleware на чувствительные маршруты (админка).
+func RequireMFA() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !c.GetBool("mfa") {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": "Two-factor authentication required",
+				"code":  "mfa_required",
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequirePermission пускает только владельцев токена с правом perm (claim "perms").
+// Ставится после AuthMiddleware; права приходят из ролей в Auth.
+func RequirePermission(perm string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !HasPermission(c, perm) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": "Access denied",
+				"code":  "permission_denied",
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
+// HasPermission — для ручек, где право не закрывает доступ, а меняет ответ
+func HasPermission(c *gin.Context, perm string) bool {
+	for _, p := range c.GetStringSlice("permissions") {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
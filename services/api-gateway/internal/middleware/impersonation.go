@@ -0,0 +1,46 @@
+package middleware
+
+import (
+	"net/http"
+
+	"api-gateway/internal/client"
+	authpb "api-gateway/pkg/authpb/proto/auth"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ImpersonationAuditor пишет в журнал Auth каждый запрос, сделанный с токеном имперсонации
+type ImpersonationAuditor struct {
+	authClient *client.AuthClient
+}
+
+func NewImpersonationAuditor(authClient *client.AuthClient) *ImpersonationAuditor {
+	return &ImpersonationAuditor{authClient: authClient}
+}
+
+func (a *ImpersonationAuditor) Record(c *gin.Context, claims *AccessClaims) error {
+	_, err := a.authClient.Client.RecordImpersonatedRequest(c, &authpb.RecordImpersonatedRequestRequest{
+		ActorId: claims.ActorID,
+		UserId:  claims.UserID,
+		TokenId: claims.TokenID,
+		Method:  c.Request.Method,
+		Path:    c.Request.URL.RequestURI(),
+		Ip:      c.ClientIP(),
+	})
+	return err
+}
+
+// DenyDelegated закрывает маршрут для токенов имперсонации и API-ключей: платежи, пароль, почта,
+// 2FA, сами ключи и все, что нельзя делать не из своей сессии. Ставится после AuthMiddleware.
+func DenyDelegated() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.GetString("actorId") != "" || c.GetString("apiKeyId") != "" {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": "Not available for API keys or while acting as another user",
+				"code":  "delegated_forbidden",
+			})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,19 @@
+package middleware
+
+// Права из claim "perms" — должны совпадать с domain.RolePermissions в Auth
+const (
+	PermCoursesWrite   = "courses:write"
+	PermContentAll     = "content:all"
+	PermUsersRead      = "users:read"
+	PermSessionsRevoke = "sessions:revoke"
+	PermRolesManage    = "roles:manage"
+	PermImpersonate    = "users:impersonate"
+	PermUsersBan       = "users:ban"
+	PermShopManage     = "shop:manage"
+)
+
+// Scope API-ключа — должны совпадать с domain.ScopeRead/ScopeWrite в Auth
+const (
+	ScopeRead  = "read"
+	ScopeWrite = "write"
+)
@@ -0,0 +1,246 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"sync"
+	"time"
+
+	"api-gateway/internal/client"
+	authpb "api-gateway/pkg/authpb/proto/auth"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// Как часто перечитываем JWKS, даже если все kid знакомы
+	jwksRefreshInterval = time.Hour
+	// Неизвестный kid не должен превращать каждый запрос в поход в Auth
+	jwksMinRefreshInterval = 30 * time.Second
+)
+
+// AccessClaims — данные пользователя из проверенного access-токена
+type AccessClaims struct {
+	UserID   string
+	DeviceID string
+	MFA      bool // Вход подтвержден вторым фактором (claim "amr")
+	Roles    []string
+	// Права ролей (claim "perms") — их проверяет RequirePermission
+	Permissions []string
+	TokenID     string
+	// Не пусто — токен имперсонации: сотрудник ActorID действует от имени UserID (claim "act")
+	ActorID string
+	// Не пусто — запрос пришел с API-ключом, а не с токеном сессии
+	APIKeyID string
+	Scopes   []string
+}
+
+// TokenVerifier проверяет access-токены локально по публичным ключам Auth-сервиса.
+// Отзыв токенов (logout, сброс пароля) Auth пишет в общий Redis — его смотрим здесь же.
+type TokenVerifier struct {
+	authClient *client.AuthClient
+	rdb        *redis.Client
+
+	mu        sync.RWMutex
+	keys      map[string]interface{}
+	fetchedAt time.Time
+}
+
+func NewTokenVerifier(authClient *client.AuthClient, rdb *redis.Client) *TokenVerifier {
+	return &TokenVerifier{authClient: authClient, rdb: rdb, keys: map[string]interface{}{}}
+}
+
+func (v *TokenVerifier) Verify(ctx context.Context, tokenStr string) (*AccessClaims, error) {
+	token, err := jwt.Parse(tokenStr, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.key(ctx, kid)
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}))
+	if err != nil {
+		return nil, err
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || claims["type"] != "access" {
+		return nil, errors.New("invalid token")
+	}
+
+	sub, _ := claims["sub"].(string)
+	did, _ := claims["did"].(string)
+	jti, _ := claims["jti"].(string)
+	iat, hasIat := issuedAt(claims)
+	if sub == "" || jti == "" || !hasIat {
+		return nil, errors.New("invalid token")
+	}
+
+	if err := v.checkRevoked(ctx, sub, jti, iat); err != nil {
+		return nil, err
+	}
+
+	return &AccessClaims{
+		UserID:      sub,
+		DeviceID:    did,
+		MFA:         hasMFA(claims),
+		Roles:       stringList(claims, "roles"),
+		Permissions: stringList(claims, "perms"),
+		TokenID:     jti,
+		ActorID:     actor(claims),
+	}, nil
+}
+
+// VerifyAPIKey проверяет ключ из X-API-Key. Ключи хранит только Auth (хэшами), поэтому
+// здесь без локальной проверки: каждый запрос с ключом — вызов ValidateAPIKey.
+func (v *TokenVerifier) VerifyAPIKey(ctx context.Context, key, ip string) (*AccessClaims, error) {
+	res, err := v.authClient.Client.ValidateAPIKey(ctx, &authpb.ValidateAPIKeyRequest{Key: key, Ip: ip})
+	if err != nil {
+		return nil, err
+	}
+	return &AccessClaims{
+		UserID:      res.UserId,
+		Roles:       res.Roles,
+		Permissions: res.Permissions,
+		APIKeyID:    res.KeyId,
+		Scopes:      res.Scopes,
+	}, nil
+}
+
+func actor(claims jwt.MapClaims) string {
+	act, _ := claims["act"].(map[string]interface{})
+	sub, _ := act["sub"].(string)
+	return sub
+}
+
+func stringList(claims jwt.MapClaims, name string) []string {
+	raw, _ := claims[name].([]interface{})
+	list := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+func hasMFA(claims jwt.MapClaims) bool {
+	amr, _ := claims["amr"].([]interface{})
+	for _, m := range amr {
+		if m == "mfa" {
+			return true
+		}
+	}
+	return false
+}
+
+// issuedAt читает "iat" с миллисекундами (claims.GetIssuedAt округляет до секунд):
+// с ним сравнивается момент отзыва, который Auth-сервис тоже хранит в миллисекундах
+func issuedAt(claims jwt.MapClaims) (time.Time, bool) {
+	iat, ok := claims["iat"].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(int64(math.Round(iat * 1000))), true
+}
+
+// checkRevoked смотрит denylist, который ведет Auth-сервис (ключи должны совпадать с cache.TokenCache)
+func (v *TokenVerifier) checkRevoked(ctx context.Context, userID, jti string, issuedAt time.Time) error {
+	vals, err := v.rdb.MGet(ctx, "revoked_jti:"+jti, "revoked_before:"+userID).Result()
+	if err != nil {
+		return err
+	}
+	if vals[0] != nil {
+		return errors.New("token revoked")
+	}
+	if before, ok := vals[1].(string); ok {
+		ts, _ := strconv.ParseInt(before, 10, 64)
+		if issuedAt.UnixMilli() < ts {
+			return errors.New("token revoked")
+		}
+	}
+	return nil
+}
+
+func (v *TokenVerifier) key(ctx context.Context, kid string) (interface{}, error) {
+	v.mu.RLock()
+	key, ok := v.keys[kid]
+	stale := time.Since(v.fetchedAt) > jwksRefreshInterval
+	canRefresh := time.Since(v.fetchedAt) > jwksMinRefreshInterval
+	v.mu.RUnlock()
+
+	if ok && !stale {
+		return key, nil
+	}
+	if !ok && !canRefresh {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	if err := v.refresh(ctx); err != nil {
+		// Auth недоступен — работаем на известных ключах
+		if ok {
+			return key, nil
+		}
+		return nil, err
+	}
+
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	if key, ok := v.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+func (v *TokenVerifier) refresh(ctx context.Context) error {
+	res, err := v.authClient.Client.GetJWKS(ctx, &authpb.GetJWKSRequest{})
+	if err != nil {
+		return err
+	}
+
+	keys := make(map[string]interface{}, len(res.Keys))
+	for _, k := range res.Keys {
+		pub, err := parseJWK(k)
+		if err != nil {
+			return fmt.Errorf("jwk %s: %w", k.Kid, err)
+		}
+		keys[k.Kid] = pub
+	}
+
+	v.mu.Lock()
+	v.keys = keys
+	v.fetchedAt = time.Now()
+	v.mu.Unlock()
+	return nil
+}
+
+func parseJWK(k *authpb.JWK) (interface{}, error) {
+	b64 := base64.RawURLEncoding
+	switch k.Kty {
+	case "RSA":
+		n, err := b64.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := b64.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "OKP":
+		x, err := b64.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if k.Crv != "Ed25519" || len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("unsupported OKP key")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
@@ -0,0 +1,98 @@
+package handlers
+
+import (
+	authpb "api-gateway/pkg/authpb/proto/auth"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type deleteAccountReq struct {
+	Password      string `json:"password"` // Пусто у аккаунтов без пароля — придет письмо со ссылкой
+	TwoFactorCode string `json:"two_factor_code"`
+}
+
+// POST /api/v1/user/account/delete
+func (h *AuthHandler) DeleteAccount(c *gin.Context) {
+	var req deleteAccountReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.client.Client.DeleteAccount(c, &authpb.DeleteAccountRequest{
+		UserId:        c.GetString("userId"),
+		Password:      req.Password,
+		TwoFactorCode: req.TwoFactorCode,
+	})
+	if err != nil {
+		deletionError(c, err)
+		return
+	}
+
+	if res.ConfirmationSent {
+		c.JSON(http.StatusAccepted, gin.H{"status": "confirmation_sent"})
+		return
+	}
+	// Все сессии уже отозваны в Auth
+	c.SetCookie("refresh_token", "", -1, "/", "", false, true)
+	writeDeletionScheduled(c, res.DeleteAfter)
+}
+
+// GET /api/v1/auth/account/delete/confirm?token=... — ссылка из письма для аккаунтов без пароля
+func (h *AuthHandler) ConfirmAccountDeletion(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token missing"})
+		return
+	}
+
+	res, err := h.client.Client.ConfirmAccountDeletion(c, &authpb.ConfirmAccountDeletionRequest{Token: token})
+	if err != nil {
+		deletionError(c, err)
+		return
+	}
+	c.SetCookie("refresh_token", "", -1, "/", "", false, true)
+	writeDeletionScheduled(c, res.DeleteAfter)
+}
+
+// GET /api/v1/user/export — JSON-архив всех данных пользователя файлом
+func (h *AuthHandler) ExportMyData(c *gin.Context) {
+	res, err := h.client.Client.ExportMyData(c, &authpb.ExportMyDataRequest{UserId: c.GetString("userId")})
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "Export is temporarily unavailable, try again later"})
+		return
+	}
+
+	filename := "bazakursov-data-" + time.Now().UTC().Format("2006-01-02") + ".json"
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Header("Cache-Control", "no-store")
+	c.Data(http.StatusOK, "application/json; charset=utf-8", res.Data)
+}
+
+func writeDeletionScheduled(c *gin.Context, deleteAfter int64) {
+	c.JSON(http.StatusOK, gin.H{
+		"status":       "scheduled",
+		"delete_after": deleteAfter,
+		"message":      "Account will be deleted. Log in before this date to cancel",
+	})
+}
+
+func deletionError(c *gin.Context, err error) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.Unauthenticated:
+		c.JSON(http.StatusUnauthorized, gin.H{"error": st.Message()})
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired token"})
+	case codes.FailedPrecondition:
+		c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+	case codes.ResourceExhausted:
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": st.Message()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+	}
+}
@@ -0,0 +1,330 @@
+package handlers
+
+import (
+	"api-gateway/internal/client"
+	authpb "api-gateway/pkg/authpb/proto/auth"
+	paymentpb "api-gateway/pkg/paymentpb/proto/payment"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdminHandler — действия поддержки и админов над чужими аккаунтами.
+// Права проверяет RequirePermission в роутере, здесь только вызовы Auth и Payment.
+type AdminHandler struct {
+	authClient    *client.AuthClient
+	paymentClient *client.PaymentClient
+}
+
+func NewAdminHandler(ac *client.AuthClient, pc *client.PaymentClient) *AdminHandler {
+	return &AdminHandler{authClient: ac, paymentClient: pc}
+}
+
+type grantRoleReq struct {
+	Role string `json:"role" binding:"required"`
+}
+
+// GET /api/v1/admin/users/:id/roles
+func (h *AdminHandler) GetRoles(c *gin.Context) {
+	res, err := h.authClient.Client.GetRoles(c, &authpb.GetRolesRequest{UserId: c.Param("id")})
+	if err != nil {
+		roleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"roles": res.Roles, "permissions": res.Permissions})
+}
+
+// POST /api/v1/admin/users/:id/roles
+func (h *AdminHandler) GrantRole(c *gin.Context) {
+	var req grantRoleReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.authClient.Client.GrantRole(c, &authpb.GrantRoleRequest{
+		ActorId: c.GetString("userId"),
+		UserId:  c.Param("id"),
+		Role:    req.Role,
+	})
+	if err != nil {
+		roleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"roles": res.Roles, "permissions": res.Permissions})
+}
+
+// DELETE /api/v1/admin/users/:id/roles/:role
+func (h *AdminHandler) RevokeRole(c *gin.Context) {
+	res, err := h.authClient.Client.RevokeRole(c, &authpb.RevokeRoleRequest{
+		ActorId: c.GetString("userId"),
+		UserId:  c.Param("id"),
+		Role:    c.Param("role"),
+	})
+	if err != nil {
+		roleError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"roles": res.Roles, "permissions": res.Permissions})
+}
+
+// GET /api/v1/admin/users/:id/devices
+func (h *AdminHandler) GetDevices(c *gin.Context) {
+	res, err := h.authClient.Client.GetDevices(c, &authpb.GetDevicesRequest{UserId: c.Param("id")})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+		return
+	}
+	c.JSON(http.StatusOK, res.Devices)
+}
+
+// GET /api/v1/admin/users/:id/security/history?limit=50
+func (h *AdminHandler) GetLoginHistory(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	res, err := h.authClient.Client.GetLoginHistory(c, &authpb.GetLoginHistoryRequest{
+		UserId: c.Param("id"),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"events": res.Events})
+}
+
+// POST /api/v1/admin/users/:id/sessions/revoke-all
+func (h *AdminHandler) RevokeAllSessions(c *gin.Context) {
+	userID := c.Param("id")
+	_, err := h.authClient.Client.RevokeAllSessions(c, &authpb.RevokeAllSessionsRequest{UserId: userID})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+		return
+	}
+	log.Printf("[ADMIN] %s revoked all sessions of %s", c.GetString("userId"), userID)
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+type impersonateReq struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+// POST /api/v1/admin/users/:id/impersonate — токен для входа от имени пользователя.
+// Refresh-токена нет: по истечении срока нужно запросить новый.
+func (h *AdminHandler) Impersonate(c *gin.Context) {
+	var req impersonateReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.authClient.Client.Impersonate(c, &authpb.ImpersonateRequest{
+		ActorId: c.GetString("userId"),
+		UserId:  c.Param("id"),
+		Reason:  req.Reason,
+		Ip:      c.ClientIP(),
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		switch st.Code() {
+		case codes.InvalidArgument:
+			c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		case codes.PermissionDenied:
+			c.JSON(http.StatusForbidden, gin.H{"error": st.Message()})
+		case codes.NotFound:
+			c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"access_token":  res.AccessToken,
+		"expires_at":    res.ExpiresAt,
+		"impersonating": c.Param("id"),
+	})
+}
+
+// GET /api/v1/admin/users/:id/impersonation-log?limit=100
+func (h *AdminHandler) GetImpersonationAudit(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	res, err := h.authClient.Client.GetImpersonationAudit(c, &authpb.GetImpersonationAuditRequest{
+		UserId: c.Param("id"),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"entries": res.Entries})
+}
+
+type banReq struct {
+	Reason        string `json:"reason" binding:"required"`
+	DurationHours int64  `json:"duration_hours"` // 0 — бессрочно
+}
+
+// POST /api/v1/admin/users/:id/ban — повторный бан заменяет действующий
+func (h *AdminHandler) BanUser(c *gin.Context) {
+	var req banReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.authClient.Client.BanUser(c, &authpb.BanUserRequest{
+		ActorId:         c.GetString("userId"),
+		UserId:          c.Param("id"),
+		Reason:          req.Reason,
+		DurationSeconds: req.DurationHours * 3600,
+	})
+	if err != nil {
+		banError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// DELETE /api/v1/admin/users/:id/ban
+func (h *AdminHandler) UnbanUser(c *gin.Context) {
+	_, err := h.authClient.Client.UnbanUser(c, &authpb.UnbanUserRequest{
+		ActorId: c.GetString("userId"),
+		UserId:  c.Param("id"),
+	})
+	if err != nil {
+		banError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// GET /api/v1/admin/users/:id/bans
+func (h *AdminHandler) GetBans(c *gin.Context) {
+	res, err := h.authClient.Client.GetBans(c, &authpb.GetBansRequest{UserId: c.Param("id")})
+	if err != nil {
+		banError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"bans": res.Bans})
+}
+
+// GET /api/v1/admin/orders?user_id=&status=&limit=20&offset=0
+func (h *AdminHandler) ListOrders(c *gin.Context) {
+	listOrders(c, h.paymentClient, c.Query("user_id"))
+}
+
+// GET /api/v1/admin/orders/:id
+func (h *AdminHandler) GetOrder(c *gin.Context) {
+	getOrder(c, h.paymentClient, "")
+}
+
+type wheelPrizesReq struct {
+	Prizes []struct {
+		Type   string `json:"prize_type"`
+		Value  int32  `json:"prize_value"`
+		Weight int32  `json:"weight"`
+		Label  string `json:"label"`
+	} `json:"prizes" binding:"required"`
+}
+
+// GET /api/v1/admin/wheel/prizes
+func (h *AdminHandler) GetWheelPrizes(c *gin.Context) {
+	res, err := h.paymentClient.Client.GetWheelPrizes(c, &paymentpb.GetWheelPrizesRequest{})
+	if err != nil {
+		wheelError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"prizes": res.Prizes, "spin_cost": res.SpinCost})
+}
+
+// PUT /api/v1/admin/wheel/prizes — таблица призов заменяется целиком
+func (h *AdminHandler) SetWheelPrizes(c *gin.Context) {
+	var req wheelPrizesReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "prizes is required"})
+		return
+	}
+
+	prizes := make([]*paymentpb.WheelPrize, 0, len(req.Prizes))
+	for _, p := range req.Prizes {
+		prizes = append(prizes, &paymentpb.WheelPrize{
+			PrizeType:  p.Type,
+			PrizeValue: p.Value,
+			Weight:     p.Weight,
+			Label:      p.Label,
+		})
+	}
+	res, err := h.paymentClient.Client.SetWheelPrizes(c, &paymentpb.SetWheelPrizesRequest{
+		ActorId: c.GetString("userId"),
+		Prizes:  prizes,
+	})
+	if err != nil {
+		wheelError(c, err)
+		return
+	}
+	log.Printf("[ADMIN] %s replaced wheel prizes", c.GetString("userId"))
+	c.JSON(http.StatusOK, gin.H{"prizes": res.Prizes, "spin_cost": res.SpinCost})
+}
+
+// GET /api/v1/admin/users/:id/wheel-spins?limit=20&offset=0
+func (h *AdminHandler) GetWheelSpins(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+
+	res, err := h.paymentClient.Client.ListWheelSpins(c, &paymentpb.ListWheelSpinsRequest{
+		UserId: c.Param("id"),
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		wheelError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"spins": res.Spins, "total": res.TotalCount})
+}
+
+func wheelError(c *gin.Context, err error) {
+	st, _ := status.FromError(err)
+	if st.Code() == codes.InvalidArgument {
+		c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+}
+
+func banError(c *gin.Context, err error) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+	case codes.PermissionDenied:
+		c.JSON(http.StatusForbidden, gin.H{"error": st.Message()})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+	case codes.FailedPrecondition:
+		c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+	}
+}
+
+func roleError(c *gin.Context, err error) {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Unknown role"})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "User not found"})
+	case codes.FailedPrecondition:
+		c.JSON(http.StatusConflict, gin.H{"error": "Cannot revoke the last admin"})
+	case codes.PermissionDenied:
+		c.JSON(http.StatusForbidden, gin.H{"error": "Permission denied"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+	}
+}
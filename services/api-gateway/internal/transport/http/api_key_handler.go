@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	authpb "api-gateway/pkg/authpb/proto/auth"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type createAPIKeyReq struct {
+	Name      string     `json:"name" binding:"required"`
+	Scopes    []string   `json:"scopes"` // Пусто — только read
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// GET /api/v1/user/api-keys
+func (h *AuthHandler) ListAPIKeys(c *gin.Context) {
+	res, err := h.client.Client.ListAPIKeys(c, &authpb.ListAPIKeysRequest{UserId: c.GetString("userId")})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+		return
+	}
+	c.JSON(http.StatusOK, res.ApiKeys)
+}
+
+// POST /api/v1/user/api-keys — сам ключ отдается только в этом ответе
+func (h *AuthHandler) CreateAPIKey(c *gin.Context) {
+	var req createAPIKeyReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var expiresAt int64
+	if req.ExpiresAt != nil {
+		expiresAt = req.ExpiresAt.Unix()
+	}
+
+	res, err := h.client.Client.CreateAPIKey(c, &authpb.CreateAPIKeyRequest{
+		UserId:    c.GetString("userId"),
+		Name:      req.Name,
+		Scopes:    req.Scopes,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		apiKeyError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"key": res.Key, "api_key": res.ApiKey})
+}
+
+// DELETE /api/v1/user/api-keys/:id
+func (h *AuthHandler) RevokeAPIKey(c *gin.Context) {
+	_, err := h.client.Client.RevokeAPIKey(c, &authpb.RevokeAPIKeyRequest{
+		UserId: c.GetString("userId"),
+		Id:     c.Param("id"),
+	})
+	if err != nil {
+		apiKeyError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "revoked"})
+}
+
+func apiKeyError(c *gin.Context, err error) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "API key not found"})
+	case codes.ResourceExhausted:
+		c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+	}
+}
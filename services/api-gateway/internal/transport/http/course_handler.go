@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"api-gateway/internal/client"
+	"api-gateway/internal/middleware"
 	coursepb "api-gateway/pkg/coursepb/proto/course"
 	userpb "api-gateway/pkg/userpb/proto/user" // <--- Добавили импорт
 	"fmt"
@@ -51,8 +52,9 @@ func (h *CourseHandler) GetOne(c *gin.Context) {
 	courseID := c.Param("id")
 
 	res, err := h.courseClient.Client.GetCourse(c, &coursepb.GetCourseRequest{
-		CourseId: courseID,
-		UserId:   userID,
+		CourseId:   courseID,
+		UserId:     userID,
+		FullAccess: middleware.HasPermission(c, middleware.PermContentAll),
 	})
 
 	if err != nil {
@@ -65,31 +67,8 @@ func (h *CourseHandler) GetOne(c *gin.Context) {
 	c.JSON(http.StatusOK, res)
 }
 
-// POST /api/v1/courses
+// POST /api/v1/courses — право courses:write проверяет RequirePermission
 func (h *CourseHandler) Create(c *gin.Context) {
-	// 1. Получаем ID текущего юзера
-	userID := c.GetString("userId")
-	if userID == "" {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Unauthorized"})
-		return
-	}
-
-	// 2. Делаем запрос в User Service, чтобы узнать статус подписки
-	profile, err := h.userClient.Client.GetProfile(c, &userpb.GetProfileRequest{
-		UserId: userID,
-	})
-	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Failed to verify user profile"})
-		return
-	}
-
-	// 3. ПРОВЕРКА: Только admin может создавать
-	if profile.SubscriptionStatus != "admin" {
-		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied: admins only"})
-		return
-	}
-
-	// 4. Если админ, создаем курс
 	var req coursepb.CreateCourseRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -103,18 +82,10 @@ func (h *CourseHandler) Create(c *gin.Context) {
 	c.JSON(http.StatusCreated, res)
 }
 
-// DELETE /api/v1/courses/:id
+// DELETE /api/v1/courses/:id — право courses:write проверяет RequirePermission
 func (h *CourseHandler) Delete(c *gin.Context) {
-	// 1. Сюда тоже лучше добавить проверку на админа
-	userID := c.GetString("userId")
-	profile, err := h.userClient.Client.GetProfile(c, &userpb.GetProfileRequest{UserId: userID})
-	if err != nil || profile.SubscriptionStatus != "admin" {
-		c.JSON(http.StatusForbidden, gin.H{"error": "Access denied"})
-		return
-	}
-
 	id := c.Param("id")
-	_, err = h.courseClient.Client.DeleteCourse(c, &coursepb.DeleteCourseRequest{Id: id})
+	_, err := h.courseClient.Client.DeleteCourse(c, &coursepb.DeleteCourseRequest{Id: id})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -144,10 +115,11 @@ func (h *CourseHandler) StartCourse(c *gin.Context) {
 	}
 
 	_, err := h.userClient.Client.StartCourse(c, &userpb.StartCourseRequest{
-		UserId:   userID,
-		CourseId: courseID,
-		Title:    req.Title,
-		CoverUrl: req.CoverURL,
+		UserId:     userID,
+		CourseId:   courseID,
+		Title:      req.Title,
+		CoverUrl:   req.CoverURL,
+		FullAccess: middleware.HasPermission(c, middleware.PermContentAll),
 	})
 
 	if err != nil {
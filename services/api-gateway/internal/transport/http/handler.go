@@ -4,15 +4,18 @@ import (
 	"api-gateway/internal/client"
 	authpb "api-gateway/pkg/authpb/proto/auth"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type registerReq struct {
 	Email    string `json:"email" binding:"required,email"`
 	Username string `json:"username" binding:"required"`
-	Password string `json:"password" binding:"required,min=6"`
+	Password string `json:"password" binding:"required"` // Политику пароля проверяет Auth
 }
 
 type loginReq struct {
@@ -26,15 +29,39 @@ type forgotReq struct {
 	Email string `json:"email" binding:"required,email"`
 }
 
+type magicLinkLoginReq struct {
+	Token      string `json:"token" binding:"required"`
+	DeviceId   string `json:"device_id" binding:"required"`
+	DeviceName string `json:"device_name"`
+}
+
 type resetReq struct {
 	Token       string `json:"token" binding:"required"`
-	NewPassword string `json:"new_password" binding:"required,min=6"`
+	NewPassword string `json:"new_password" binding:"required"`
 }
 
 type logoutReq struct {
 	DeviceId string `json:"device_id"`
 }
 
+type verifyTwoFactorReq struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	Code           string `json:"code" binding:"required"`
+}
+
+type replaceDeviceReq struct {
+	ChallengeToken string `json:"challenge_token" binding:"required"`
+	DeviceId       string `json:"device_id" binding:"required"`
+}
+
+type twoFactorCodeReq struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type revokeAllReq struct {
+	KeepCurrent bool `json:"keep_current"`
+}
+
 type AuthHandler struct {
 	client *client.AuthClient
 }
@@ -56,6 +83,10 @@ func (h *AuthHandler) Register(c *gin.Context) {
 		Password: req.Password,
 	})
 
+	if status.Code(err) == codes.InvalidArgument {
+		c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message(), "code": "weak_password"})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -64,6 +95,40 @@ func (h *AuthHandler) Register(c *gin.Context) {
 	c.JSON(http.StatusCreated, gin.H{"user_id": res.UserId})
 }
 
+// GET /api/v1/auth/verify-email?token=...
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token missing"})
+		return
+	}
+
+	_, err := h.client.Client.VerifyEmail(c, &authpb.VerifyEmailRequest{Token: token})
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired token"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Email verified"})
+}
+
+// POST /api/v1/user/email/resend-verification
+func (h *AuthHandler) ResendVerificationEmail(c *gin.Context) {
+	_, err := h.client.Client.ResendVerificationEmail(c, &authpb.ResendVerificationEmailRequest{
+		UserId: c.GetString("userId"),
+	})
+	if err != nil {
+		if status.Code(err) == codes.FailedPrecondition {
+			c.JSON(http.StatusConflict, gin.H{"error": "Email already verified"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Verification email sent"})
+}
+
 func (h *AuthHandler) Login(c *gin.Context) {
 	var req loginReq
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -76,25 +141,134 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		Password:   req.Password,
 		DeviceId:   req.DeviceId,
 		DeviceName: req.DeviceName,
+		Ip:         c.ClientIP(),
+		UserAgent:  c.Request.UserAgent(),
 	})
 
 	if err != nil {
-		println("LOGIN ERROR FROM GRPC:", err.Error())
-		if strings.Contains(err.Error(), "limit reached") {
+		st, _ := status.FromError(err)
+		switch {
+		case st.Code() == codes.Unauthenticated:
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid credentials"})
+		case st.Code() == codes.ResourceExhausted:
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": st.Message()})
+		case st.Code() == codes.PermissionDenied:
+			writeBanned(c, st)
+		case strings.Contains(st.Message(), "limit reached"):
 			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Device limit reached for your subscription"})
-			return
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
 		}
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid credentials"})
+		return
+	}
+
+	writeLogin(c, res)
+}
+
+// writeLogin отдает результат входа: токены или челлендж второго фактора
+func writeLogin(c *gin.Context, res *authpb.LoginResponse) {
+	// Личность подтверждена, но нужен код из приложения — токены выдаст /auth/2fa/verify
+	if res.TwoFactorRequired {
+		c.JSON(http.StatusOK, gin.H{
+			"two_factor_required": true,
+			"challenge_token":     res.ChallengeToken,
+		})
+		return
+	}
+	// Лимит устройств исчерпан: фронт показывает список, выбранное устройство уходит в /auth/devices/replace
+	if res.DeviceConfirmationRequired {
+		c.JSON(http.StatusConflict, gin.H{
+			"device_confirmation_required": true,
+			"challenge_token":              res.DeviceChallengeToken,
+			"devices":                      res.Devices,
+		})
 		return
 	}
 
 	c.SetCookie("refresh_token", res.RefreshToken, 7*24*3600, "/", "", false, true)
 
 	c.JSON(http.StatusOK, gin.H{
-		"access_token": res.AccessToken,
+		"access_token":              res.AccessToken,
+		"two_factor_setup_required": res.TwoFactorSetupRequired,
 	})
 }
 
+// writeBanned — вход забаненного: 403 с причиной и сроком бана из Auth
+func writeBanned(c *gin.Context, st *status.Status) {
+	c.JSON(http.StatusForbidden, gin.H{"error": st.Message(), "code": "account_banned"})
+}
+
+// GET /api/v1/auth/unlock?token=...
+func (h *AuthHandler) UnlockAccount(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token missing"})
+		return
+	}
+
+	_, err := h.client.Client.UnlockAccount(c, &authpb.UnlockAccountRequest{Token: token})
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired token"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Account unlocked"})
+}
+
+// POST /api/v1/auth/2fa/verify
+func (h *AuthHandler) VerifyTwoFactor(c *gin.Context) {
+	var req verifyTwoFactorReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.client.Client.VerifyTwoFactor(c, &authpb.VerifyTwoFactorRequest{
+		ChallengeToken: req.ChallengeToken,
+		Code:           req.Code,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "limit reached") {
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Device limit reached for your subscription"})
+			return
+		}
+		twoFactorError(c, err)
+		return
+	}
+
+	writeLogin(c, res)
+}
+
+// POST /api/v1/auth/devices/replace — завершить вход, отключив выбранное устройство
+func (h *AuthHandler) ReplaceDevice(c *gin.Context) {
+	var req replaceDeviceReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.client.Client.ReplaceDevice(c, &authpb.ReplaceDeviceRequest{
+		ChallengeToken: req.ChallengeToken,
+		DeviceId:       req.DeviceId,
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		switch st.Code() {
+		case codes.Unauthenticated:
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Confirmation expired, log in again"})
+		case codes.ResourceExhausted:
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": "Device limit reached for your subscription"})
+		case codes.PermissionDenied:
+			writeBanned(c, st)
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+		}
+		return
+	}
+
+	writeLogin(c, res)
+}
+
 func (h *AuthHandler) Refresh(c *gin.Context) {
 	refreshToken, err := c.Cookie("refresh_token")
 	if err != nil {
@@ -106,6 +280,11 @@ func (h *AuthHandler) Refresh(c *gin.Context) {
 		RefreshToken: refreshToken,
 	})
 
+	if st, _ := status.FromError(err); st.Code() == codes.PermissionDenied {
+		c.SetCookie("refresh_token", "", -1, "/", "", false, true)
+		writeBanned(c, st)
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
 		return
@@ -131,6 +310,7 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 
 	_, _ = h.client.Client.Logout(c, &authpb.LogoutRequest{
 		RefreshToken: refreshToken,
+		AccessToken:  strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer "),
 		DeviceId:     req.DeviceId,
 	})
 
@@ -158,6 +338,56 @@ func (h *AuthHandler) ForgotPassword(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "If email exists, a reset link has been sent"})
 }
 
+// POST /api/v1/auth/magic-link — ссылка для входа без пароля уходит на почту
+func (h *AuthHandler) RequestMagicLink(c *gin.Context) {
+	var req forgotReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	_, err := h.client.Client.RequestMagicLink(c, &authpb.RequestMagicLinkRequest{Email: req.Email})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "If email exists, a sign-in link has been sent"})
+}
+
+// POST /api/v1/auth/magic-link/verify — фронт открывает ссылку из письма и присылает токен со своим device_id
+func (h *AuthHandler) MagicLinkLogin(c *gin.Context) {
+	var req magicLinkLoginReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.client.Client.MagicLinkLogin(c, &authpb.MagicLinkLoginRequest{
+		Token:      req.Token,
+		DeviceId:   req.DeviceId,
+		DeviceName: req.DeviceName,
+		Ip:         c.ClientIP(),
+		UserAgent:  c.Request.UserAgent(),
+	})
+	if err != nil {
+		st, _ := status.FromError(err)
+		switch st.Code() {
+		case codes.Unauthenticated:
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired link"})
+		case codes.ResourceExhausted:
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": st.Message()})
+		case codes.PermissionDenied:
+			writeBanned(c, st)
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+		}
+		return
+	}
+
+	writeLogin(c, res)
+}
+
 func (h *AuthHandler) ResetPassword(c *gin.Context) {
 	var req resetReq
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -170,6 +400,10 @@ func (h *AuthHandler) ResetPassword(c *gin.Context) {
 		NewPassword: req.NewPassword,
 	})
 
+	if status.Code(err) == codes.InvalidArgument {
+		c.JSON(http.StatusBadRequest, gin.H{"error": status.Convert(err).Message(), "code": "weak_password"})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired token"})
 		return
@@ -182,7 +416,8 @@ func (h *AuthHandler) GetDevices(c *gin.Context) {
 	userID := c.GetString("userId")
 
 	res, err := h.client.Client.GetDevices(c, &authpb.GetDevicesRequest{
-		UserId: userID,
+		UserId:          userID,
+		CurrentDeviceId: c.GetString("deviceId"),
 	})
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
@@ -192,6 +427,44 @@ func (h *AuthHandler) GetDevices(c *gin.Context) {
 	c.JSON(http.StatusOK, res.Devices)
 }
 
+// GET /api/v1/user/security/history?limit=50
+func (h *AuthHandler) GetLoginHistory(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+
+	res, err := h.client.Client.GetLoginHistory(c, &authpb.GetLoginHistoryRequest{
+		UserId: c.GetString("userId"),
+		Limit:  int32(limit),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"events": res.Events})
+}
+
+// GET /api/v1/auth/devices/revoke?token=... — ссылка «это был не я» из письма о новом устройстве
+func (h *AuthHandler) RevokeDeviceByToken(c *gin.Context) {
+	token := c.Query("token")
+	if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "token missing"})
+		return
+	}
+
+	_, err := h.client.Client.RevokeDeviceByToken(c, &authpb.RevokeDeviceByTokenRequest{Token: token})
+	if err != nil {
+		st, _ := status.FromError(err)
+		if st.Code() == codes.InvalidArgument {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid or expired token"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Device signed out. We recommend changing your password"})
+}
+
 func (h *AuthHandler) RemoveDevice(c *gin.Context) {
 	userID := c.GetString("userId")
 	deviceID := c.Param("id")
@@ -208,3 +481,163 @@ func (h *AuthHandler) RemoveDevice(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"success": true})
 }
+
+// POST /api/v1/user/sessions/revoke-all
+func (h *AuthHandler) RevokeAllSessions(c *gin.Context) {
+	userID := c.GetString("userId")
+
+	var req revokeAllReq
+	_ = c.ShouldBindJSON(&req)
+
+	exceptDevice := ""
+	if req.KeepCurrent {
+		exceptDevice = c.GetString("deviceId")
+	}
+
+	_, err := h.client.Client.RevokeAllSessions(c, &authpb.RevokeAllSessionsRequest{
+		UserId:         userID,
+		ExceptDeviceId: exceptDevice,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !req.KeepCurrent {
+		c.SetCookie("refresh_token", "", -1, "/", "", false, true)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// GET /.well-known/jwks.json
+func (h *AuthHandler) JWKS(c *gin.Context) {
+	res, err := h.client.Client.GetJWKS(c, &authpb.GetJWKSRequest{})
+	if err != nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "JWKS unavailable"})
+		return
+	}
+
+	keys := make([]gin.H, 0, len(res.Keys))
+	for _, k := range res.Keys {
+		jwk := gin.H{"kid": k.Kid, "kty": k.Kty, "alg": k.Alg, "use": k.Use}
+		if k.Kty == "RSA" {
+			jwk["n"], jwk["e"] = k.N, k.E
+		} else {
+			jwk["crv"], jwk["x"] = k.Crv, k.X
+		}
+		keys = append(keys, jwk)
+	}
+
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, gin.H{"keys": keys})
+}
+
+// GET /api/v1/auth/2fa/status
+func (h *AuthHandler) TwoFactorStatus(c *gin.Context) {
+	res, err := h.client.Client.GetTwoFactorStatus(c, &authpb.GetTwoFactorStatusRequest{
+		UserId: c.GetString("userId"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"enabled":             res.Enabled,
+		"recovery_codes_left": res.RecoveryCodesLeft,
+	})
+}
+
+// POST /api/v1/auth/2fa/setup
+func (h *AuthHandler) SetupTwoFactor(c *gin.Context) {
+	res, err := h.client.Client.SetupTwoFactor(c, &authpb.SetupTwoFactorRequest{
+		UserId: c.GetString("userId"),
+	})
+	if err != nil {
+		twoFactorError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"secret":      res.Secret,
+		"otpauth_uri": res.OtpauthUri,
+	})
+}
+
+// POST /api/v1/auth/2fa/enable
+func (h *AuthHandler) EnableTwoFactor(c *gin.Context) {
+	var req twoFactorCodeReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.client.Client.EnableTwoFactor(c, &authpb.EnableTwoFactorRequest{
+		UserId: c.GetString("userId"),
+		Code:   req.Code,
+	})
+	if err != nil {
+		twoFactorError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"recovery_codes": res.RecoveryCodes})
+}
+
+// POST /api/v1/auth/2fa/disable
+func (h *AuthHandler) DisableTwoFactor(c *gin.Context) {
+	var req twoFactorCodeReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	_, err := h.client.Client.DisableTwoFactor(c, &authpb.DisableTwoFactorRequest{
+		UserId: c.GetString("userId"),
+		Code:   req.Code,
+	})
+	if err != nil {
+		twoFactorError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// POST /api/v1/auth/2fa/recovery-codes
+func (h *AuthHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	var req twoFactorCodeReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.client.Client.RegenerateRecoveryCodes(c, &authpb.RegenerateRecoveryCodesRequest{
+		UserId: c.GetString("userId"),
+		Code:   req.Code,
+	})
+	if err != nil {
+		twoFactorError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"recovery_codes": res.RecoveryCodes})
+}
+
+// twoFactorError отдает статус по коду gRPC: неверный код — 401, неподходящее состояние — 409
+func twoFactorError(c *gin.Context, err error) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.Unauthenticated:
+		c.JSON(http.StatusUnauthorized, gin.H{"error": st.Message()})
+	case codes.FailedPrecondition:
+		c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+	case codes.ResourceExhausted:
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": st.Message()})
+	case codes.PermissionDenied:
+		writeBanned(c, st)
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+	}
+}
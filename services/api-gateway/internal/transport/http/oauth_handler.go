@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	authpb "api-gateway/pkg/authpb/proto/auth"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type oauthStartReq struct {
+	DeviceId   string `json:"device_id" binding:"required"`
+	DeviceName string `json:"device_name"`
+}
+
+// Фронтенд пересылает сюда параметры, с которыми провайдер вернул пользователя на redirect_uri
+type oauthCallbackReq struct {
+	Code  string `json:"code" binding:"required"`
+	State string `json:"state" binding:"required"`
+	// Только VK ID: device_id из redirect провайдера
+	VKDeviceId string `json:"vk_device_id"`
+}
+
+type telegramLoginReq struct {
+	// Объект user из Telegram Login Widget как есть (id, first_name, auth_date, hash, ...)
+	AuthData   map[string]string `json:"auth_data" binding:"required"`
+	DeviceId   string            `json:"device_id" binding:"required"`
+	DeviceName string            `json:"device_name"`
+}
+
+type telegramLinkReq struct {
+	AuthData map[string]string `json:"auth_data" binding:"required"`
+}
+
+// POST /api/v1/auth/oauth/:provider/start
+func (h *AuthHandler) StartOAuth(c *gin.Context) {
+	var req oauthStartReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.client.Client.StartOAuth(c, &authpb.StartOAuthRequest{
+		Provider:   c.Param("provider"),
+		DeviceId:   req.DeviceId,
+		DeviceName: req.DeviceName,
+	})
+	if err != nil {
+		oauthError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"url": res.Url})
+}
+
+// POST /api/v1/user/identities/:provider/link — то же, что start, но для привязки к текущему аккаунту
+func (h *AuthHandler) LinkOAuth(c *gin.Context) {
+	res, err := h.client.Client.StartOAuth(c, &authpb.StartOAuthRequest{
+		Provider: c.Param("provider"),
+		UserId:   c.GetString("userId"),
+	})
+	if err != nil {
+		oauthError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"url": res.Url})
+}
+
+// POST /api/v1/auth/oauth/:provider/callback
+func (h *AuthHandler) OAuthCallback(c *gin.Context) {
+	var req oauthCallbackReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.client.Client.CompleteOAuth(c, &authpb.CompleteOAuthRequest{
+		Provider:  c.Param("provider"),
+		Code:      req.Code,
+		State:     req.State,
+		DeviceId:  req.VKDeviceId,
+		Ip:        c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	})
+	if err != nil {
+		oauthError(c, err)
+		return
+	}
+
+	if res.Linked {
+		c.JSON(http.StatusOK, gin.H{"linked": true})
+		return
+	}
+	writeLogin(c, res.Login)
+}
+
+// POST /api/v1/auth/telegram
+func (h *AuthHandler) TelegramLogin(c *gin.Context) {
+	var req telegramLoginReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	res, err := h.client.Client.TelegramLogin(c, &authpb.TelegramLoginRequest{
+		AuthData:   req.AuthData,
+		DeviceId:   req.DeviceId,
+		DeviceName: req.DeviceName,
+		Ip:         c.ClientIP(),
+		UserAgent:  c.Request.UserAgent(),
+	})
+	if err != nil {
+		oauthError(c, err)
+		return
+	}
+
+	writeLogin(c, res)
+}
+
+// POST /api/v1/user/telegram/link
+func (h *AuthHandler) LinkTelegram(c *gin.Context) {
+	var req telegramLinkReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	_, err := h.client.Client.LinkTelegram(c, &authpb.LinkTelegramRequest{
+		UserId:   c.GetString("userId"),
+		AuthData: req.AuthData,
+	})
+	if err != nil {
+		oauthError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// GET /api/v1/user/identities
+func (h *AuthHandler) ListIdentities(c *gin.Context) {
+	res, err := h.client.Client.ListIdentities(c, &authpb.ListIdentitiesRequest{
+		UserId: c.GetString("userId"),
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	identities := make([]gin.H, 0, len(res.Identities))
+	for _, i := range res.Identities {
+		identities = append(identities, gin.H{
+			"provider":  i.Provider,
+			"email":     i.Email,
+			"linked_at": i.LinkedAt,
+		})
+	}
+	c.JSON(http.StatusOK, identities)
+}
+
+// DELETE /api/v1/user/identities/:provider
+func (h *AuthHandler) UnlinkIdentity(c *gin.Context) {
+	_, err := h.client.Client.UnlinkIdentity(c, &authpb.UnlinkIdentityRequest{
+		UserId:   c.GetString("userId"),
+		Provider: c.Param("provider"),
+	})
+	if err != nil {
+		oauthError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true})
+}
+
+// oauthError: неизвестный провайдер — 404, отказ провайдера — 401, конфликт аккаунтов — 409
+func oauthError(c *gin.Context, err error) {
+	st, _ := status.FromError(err)
+	switch {
+	case st.Code() == codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": st.Message()})
+	case st.Code() == codes.Unauthenticated:
+		c.JSON(http.StatusUnauthorized, gin.H{"error": st.Message()})
+	case st.Code() == codes.FailedPrecondition:
+		c.JSON(http.StatusConflict, gin.H{"error": st.Message()})
+	case st.Code() == codes.ResourceExhausted:
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": st.Message()})
+	case st.Code() == codes.PermissionDenied:
+		writeBanned(c, st)
+	case strings.Contains(st.Message(), "limit reached"):
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": "Device limit reached for your subscription"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+	}
+}
@@ -3,9 +3,15 @@ package handlers
 import (
 	"api-gateway/internal/client"
 	paymentpb "api-gateway/pkg/paymentpb/proto/payment"
+	"errors"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type PaymentHandler struct {
@@ -46,17 +52,23 @@ func (h *PaymentHandler) Redeem(c *gin.Context) {
 		return
 	}
 
+	key, ok := idempotencyKey(c)
+	if !ok {
+		return
+	}
+
 	// 3. Отправляем gRPC запрос в Payment Service
 	res, err := h.client.Client.RedeemPromo(c, &paymentpb.RedeemPromoRequest{
-		UserId: userId,
-		Code:   req.Code,
+		UserId:         userId,
+		Code:           req.Code,
+		IdempotencyKey: key,
 	})
 
 	// 4. Обработка ошибок (например, код не найден или истек)
 	if err != nil {
 		// status.Code(err) можно использовать для более точного маппинга,
 		// но пока вернем 400 и текст ошибки
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		paymentError(c, err)
 		return
 	}
 
@@ -72,12 +84,10 @@ func (h *PaymentHandler) Redeem(c *gin.Context) {
 func (h *PaymentHandler) PurchaseItem(c *gin.Context) {
 	userId := c.GetString("userId")
 
+	// Цену, название и обложку Payment Service берет у себя и в Course Service
 	var req struct {
 		ItemID   string `json:"itemId" binding:"required"`
 		ItemType string `json:"itemType" binding:"required"`
-		// Опциональные поля для покупки курса
-		CourseTitle string `json:"title"`
-		CourseCover string `json:"coverUrl"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -85,9 +95,8 @@ func (h *PaymentHandler) PurchaseItem(c *gin.Context) {
 		return
 	}
 
-	// Если это курс, и не пришли title/cover, можно вернуть ошибку
-	if req.ItemType == "COURSE" && (req.CourseTitle == "" || req.CourseCover == "") {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "title и coverUrl обязательны для покупки курса"})
+	key, ok := idempotencyKey(c)
+	if !ok {
 		return
 	}
 
@@ -95,16 +104,162 @@ func (h *PaymentHandler) PurchaseItem(c *gin.Context) {
 		UserId:         userId,
 		ItemId:         req.ItemID,
 		ItemType:       req.ItemType,
-		CourseTitle:    req.CourseTitle,
-		CourseCoverUrl: req.CourseCover,
+		IdempotencyKey: key,
 	}
 
 	res, err := h.client.Client.PurchaseItem(c, grpcReq)
 	if err != nil {
 		// Ошибки от gRPC уже в правильном формате (например, "Недостаточно снежинок")
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		paymentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// GET /api/v1/shop/catalog?type=AVATAR
+func (h *PaymentHandler) GetCatalog(c *gin.Context) {
+	res, err := h.client.Client.GetCatalog(c, &paymentpb.GetCatalogRequest{ItemType: c.Query("type")})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Не удалось получить каталог"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"items": res.Items})
+}
+
+// GET /api/v1/shop/wheel — призы с шансами, цена вращения и есть ли бесплатное сегодня
+func (h *PaymentHandler) GetWheel(c *gin.Context) {
+	res, err := h.client.Client.GetWheelPrizes(c, &paymentpb.GetWheelPrizesRequest{UserId: c.GetString("userId")})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Не удалось загрузить колесо"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"prizes":              res.Prizes,
+		"spin_cost":           res.SpinCost,
+		"free_spin_available": res.FreeSpinAvailable,
+	})
+}
+
+// POST /api/v1/shop/wheel/spin {"paid": true}
+// Без paid крутится только бесплатное вращение дня, снежинки не списываются
+func (h *PaymentHandler) SpinWheel(c *gin.Context) {
+	var req struct {
+		Paid bool `json:"paid"`
+	}
+	// Тело необязательно: пустой запрос — попытка бесплатного вращения
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Некорректное тело запроса"})
+		return
+	}
+
+	key, ok := idempotencyKey(c)
+	if !ok {
+		return
+	}
+
+	res, err := h.client.Client.SpinWheel(c, &paymentpb.SpinWheelRequest{
+		UserId:         c.GetString("userId"),
+		Paid:           req.Paid,
+		IdempotencyKey: key,
+	})
+	if err != nil {
+		// Бесплатное вращение использовано: фронт спрашивает подтверждение и повторяет с paid
+		if st, ok := status.FromError(err); ok && st.Code() == codes.AlreadyExists {
+			c.JSON(http.StatusConflict, gin.H{"error": st.Message(), "code": "paid_spin_required"})
+			return
+		}
+		paymentError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// GET /api/v1/shop/orders?status=&limit=20&offset=0
+func (h *PaymentHandler) ListOrders(c *gin.Context) {
+	listOrders(c, h.client, c.GetString("userId"))
+}
+
+// GET /api/v1/shop/orders/:id
+func (h *PaymentHandler) GetOrder(c *gin.Context) {
+	getOrder(c, h.client, c.GetString("userId"))
+}
+
+// listOrders и getOrder общие для покупателя и админа: userID пустой — без ограничения по владельцу
+func listOrders(c *gin.Context, pc *client.PaymentClient, userID string) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	offset, _ := strconv.Atoi(c.Query("offset"))
+
+	res, err := pc.Client.ListOrders(c, &paymentpb.ListOrdersRequest{
+		UserId: userID,
+		Status: c.Query("status"),
+		Limit:  int32(limit),
+		Offset: int32(offset),
+	})
+	if err != nil {
+		orderError(c, err)
 		return
 	}
+	c.JSON(http.StatusOK, gin.H{"orders": res.Orders, "total": res.TotalCount})
+}
 
+func getOrder(c *gin.Context, pc *client.PaymentClient, userID string) {
+	res, err := pc.Client.GetOrder(c, &paymentpb.GetOrderRequest{
+		OrderId: c.Param("id"),
+		UserId:  userID,
+	})
+	if err != nil {
+		orderError(c, err)
+		return
+	}
 	c.JSON(http.StatusOK, res)
 }
+
+func orderError(c *gin.Context, err error) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		c.JSON(http.StatusBadRequest, gin.H{"error": st.Message()})
+	case codes.NotFound:
+		c.JSON(http.StatusNotFound, gin.H{"error": "Order not found"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+	}
+}
+
+// Максимальная длина заголовка Idempotency-Key
+const maxIdempotencyKeyLen = 255
+
+// idempotencyKey читает необязательный заголовок Idempotency-Key: повтор запроса
+// с тем же ключом вернет ответ первого, а не выполнит покупку еще раз
+func idempotencyKey(c *gin.Context) (string, bool) {
+	key := strings.TrimSpace(c.GetHeader("Idempotency-Key"))
+	if len(key) > maxIdempotencyKeyLen {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Idempotency-Key слишком длинный"})
+		return "", false
+	}
+	return key, true
+}
+
+// paymentError: неподтвержденная почта — 403 с кодом, чтобы фронт предложил отправить письмо еще раз
+func paymentError(c *gin.Context, err error) {
+	st, ok := status.FromError(err)
+	if ok && st.Code() == codes.FailedPrecondition {
+		c.JSON(http.StatusForbidden, gin.H{"error": st.Message(), "code": "email_not_verified"})
+		return
+	}
+	if ok && st.Code() == codes.PermissionDenied {
+		c.JSON(http.StatusForbidden, gin.H{"error": st.Message(), "code": "account_banned"})
+		return
+	}
+	if ok && st.Code() == codes.Unavailable {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": st.Message()})
+		return
+	}
+	// Первый запрос с тем же Idempotency-Key еще не завершился
+	if ok && st.Code() == codes.Aborted {
+		c.JSON(http.StatusConflict, gin.H{"error": st.Message(), "code": "request_in_progress"})
+		return
+	}
+	c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+}
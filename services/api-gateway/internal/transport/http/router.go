@@ -1,7 +1,6 @@
 package handlers
 
 import (
-	"api-gateway/internal/client"
 	"api-gateway/internal/middleware"
 	"time"
 
@@ -9,16 +8,19 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func NewRouter(authHandler *AuthHandler, userHandler *UserHandler, limiter *middleware.RateLimiter, authClient *client.AuthClient, courseHandler *CourseHandler, paymentHandler *PaymentHandler) *gin.Engine {
+func NewRouter(authHandler *AuthHandler, userHandler *UserHandler, limiter *middleware.RateLimiter, verifier *middleware.TokenVerifier, auditor *middleware.ImpersonationAuditor, courseHandler *CourseHandler, paymentHandler *PaymentHandler, adminHandler *AdminHandler) *gin.Engine {
 	r := gin.Default()
 
 	config := cors.DefaultConfig()
 	config.AllowOrigins = []string{"https://bazakursov.ru", "http://bazakursov.ru", "https://www.bazakursov.ru"}
 	config.AllowCredentials = true
-	config.AllowHeaders = []string{"Origin", "Content-Length", "Content-Type", "Authorization"}
+	config.AllowHeaders = []string{"Origin", "Content-Length", "Content-Type", "Authorization", "X-API-Key", "Idempotency-Key"}
 	config.AllowMethods = []string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "PATCH"}
 	r.Use(cors.New(config))
 
+	// Публичные ключи Auth для тех, кто проверяет токены сам
+	r.GET("/.well-known/jwks.json", authHandler.JWKS)
+
 	api := r.Group("/api/v1")
 	{
 		auth := api.Group("/auth")
@@ -29,43 +31,109 @@ func NewRouter(authHandler *AuthHandler, userHandler *UserHandler, limiter *midd
 			auth.POST("/logout", authHandler.Logout)
 			auth.POST("/forgot-password", limiter.Limit("forgot_pass", 1, 5*time.Minute), authHandler.ForgotPassword)
 			auth.POST("/reset-password", authHandler.ResetPassword)
+			auth.POST("/magic-link", limiter.Limit("magic_link", 1, 1*time.Minute), authHandler.RequestMagicLink)
+			auth.POST("/magic-link/verify", limiter.Limit("magic_link_verify", 5, 1*time.Minute), authHandler.MagicLinkLogin)
+			auth.GET("/verify-email", authHandler.VerifyEmail)
+			auth.GET("/unlock", authHandler.UnlockAccount)
+			auth.GET("/account/delete/confirm", authHandler.ConfirmAccountDeletion)
+			auth.GET("/devices/revoke", authHandler.RevokeDeviceByToken)
+			auth.POST("/devices/replace", limiter.Limit("device_replace", 5, 1*time.Minute), authHandler.ReplaceDevice)
+			auth.POST("/2fa/verify", limiter.Limit("2fa_verify", 5, 1*time.Minute), authHandler.VerifyTwoFactor)
+			// Вход через Google/VK/OIDC: start отдает ссылку на провайдера, callback завершает вход или привязку
+			auth.POST("/oauth/:provider/start", limiter.Limit("oauth_start", 10, 1*time.Minute), authHandler.StartOAuth)
+			auth.POST("/oauth/:provider/callback", limiter.Limit("oauth_callback", 10, 1*time.Minute), authHandler.OAuthCallback)
+			auth.POST("/telegram", limiter.Limit("telegram_login", 5, 1*time.Minute), authHandler.TelegramLogin)
+
+			twoFactor := auth.Group("/2fa")
+			twoFactor.Use(middleware.AuthMiddleware(verifier, auditor), middleware.DenyDelegated())
+			{
+				twoFactor.GET("/status", authHandler.TwoFactorStatus)
+				twoFactor.POST("/setup", authHandler.SetupTwoFactor)
+				twoFactor.POST("/enable", authHandler.EnableTwoFactor)
+				// Оба требуют код второго фактора: лимит не дает перебирать его с украденным токеном
+				twoFactor.POST("/disable", limiter.Limit("2fa_disable", 5, 1*time.Minute), authHandler.DisableTwoFactor)
+				twoFactor.POST("/recovery-codes", limiter.Limit("2fa_recovery_codes", 5, 1*time.Minute), authHandler.RegenerateRecoveryCodes)
+			}
 		}
 		api.GET("/user/email/confirm", userHandler.ConfirmEmailChange)
 		user := api.Group("/user")
-		user.Use(middleware.AuthMiddleware(authClient))
+		user.Use(middleware.AuthMiddleware(verifier, auditor))
 		{
 			user.GET("/profile", userHandler.GetProfile)
 			user.PUT("/profile", userHandler.UpdateProfile)
 			user.POST("/avatar", userHandler.SetAvatar)
-			user.POST("/email/change", userHandler.RequestEmailChange)
+			// Токену имперсонации и API-ключу закрыто все, что меняет вход в аккаунт или сам аккаунт
+			user.POST("/email/change", middleware.DenyDelegated(), userHandler.RequestEmailChange)
+			user.POST("/email/resend-verification", limiter.Limit("resend_verify", 1, 1*time.Minute), authHandler.ResendVerificationEmail)
 			user.GET("/devices", authHandler.GetDevices)
-			user.DELETE("/devices/:id", authHandler.RemoveDevice)
+			user.DELETE("/devices/:id", middleware.DenyDelegated(), authHandler.RemoveDevice)
+			user.POST("/sessions/revoke-all", middleware.DenyDelegated(), authHandler.RevokeAllSessions)
+			user.GET("/security/history", authHandler.GetLoginHistory)
+			user.GET("/identities", authHandler.ListIdentities)
+			user.POST("/identities/:provider/link", middleware.DenyDelegated(), authHandler.LinkOAuth)
+			user.DELETE("/identities/:provider", middleware.DenyDelegated(), authHandler.UnlinkIdentity)
+			user.POST("/telegram/link", middleware.DenyDelegated(), authHandler.LinkTelegram)
+			user.POST("/account/delete", middleware.DenyDelegated(), limiter.Limit("delete_account", 5, 10*time.Minute), authHandler.DeleteAccount)
+			user.GET("/export", middleware.DenyDelegated(), limiter.Limit("export_data", 2, 10*time.Minute), authHandler.ExportMyData)
 			user.GET("/leaderboard", userHandler.GetLeaderboard)
+			user.GET("/balance/history", userHandler.GetBalanceHistory)
+			// Ключи для ботов и интеграций выпускаются только из обычной сессии
+			user.GET("/api-keys", middleware.DenyDelegated(), authHandler.ListAPIKeys)
+			user.POST("/api-keys", middleware.DenyDelegated(), limiter.Limit("create_api_key", 5, 1*time.Minute), authHandler.CreateAPIKey)
+			user.DELETE("/api-keys/:id", middleware.DenyDelegated(), authHandler.RevokeAPIKey)
 		}
 		course := api.Group("/courses")
-		course.Use(middleware.AuthMiddleware(authClient))
+		course.Use(middleware.AuthMiddleware(verifier, auditor))
 		{
 			course.GET("", courseHandler.List)
 			course.GET("/:id", courseHandler.GetOne)
-			course.POST("", courseHandler.Create)
-			course.DELETE("/:id", courseHandler.Delete)
+			// Управление курсами — по праву роли и только с подтвержденной 2FA
+			course.POST("", middleware.RequireMFA(), middleware.RequirePermission(middleware.PermCoursesWrite), courseHandler.Create)
+			course.DELETE("/:id", middleware.RequireMFA(), middleware.RequirePermission(middleware.PermCoursesWrite), courseHandler.Delete)
 			course.POST("/:id/start", courseHandler.StartCourse)
 			course.POST("/:id/progress", courseHandler.UpdateProgress)
 			course.POST("/:id/lessons/:lessonId/complete", courseHandler.CompleteLesson)
 		}
 		payment := api.Group("/payment")
-		payment.Use(middleware.AuthMiddleware(authClient))
+		payment.Use(middleware.AuthMiddleware(verifier, auditor), middleware.DenyDelegated())
 		{
 			// Публичный метод: посмотреть цены можно без регистрации
 			payment.GET("/plans", paymentHandler.GetPlans)
 			// Приватный метод: активировать код может только залогиненный юзер
-			payment.POST("/redeem", middleware.AuthMiddleware(authClient), paymentHandler.Redeem)
+			payment.POST("/redeem", middleware.AuthMiddleware(verifier, auditor), paymentHandler.Redeem)
+		}
+
+		// Поддержка и администрирование: действия над чужими аккаунтами
+		admin := api.Group("/admin")
+		admin.Use(middleware.AuthMiddleware(verifier, auditor), middleware.DenyDelegated(), middleware.RequireMFA())
+		{
+			admin.GET("/users/:id/roles", middleware.RequirePermission(middleware.PermUsersRead), adminHandler.GetRoles)
+			admin.POST("/users/:id/roles", middleware.RequirePermission(middleware.PermRolesManage), adminHandler.GrantRole)
+			admin.DELETE("/users/:id/roles/:role", middleware.RequirePermission(middleware.PermRolesManage), adminHandler.RevokeRole)
+			admin.GET("/users/:id/devices", middleware.RequirePermission(middleware.PermUsersRead), adminHandler.GetDevices)
+			admin.GET("/users/:id/security/history", middleware.RequirePermission(middleware.PermUsersRead), adminHandler.GetLoginHistory)
+			admin.POST("/users/:id/sessions/revoke-all", middleware.RequirePermission(middleware.PermSessionsRevoke), adminHandler.RevokeAllSessions)
+			admin.POST("/users/:id/impersonate", middleware.RequirePermission(middleware.PermImpersonate), adminHandler.Impersonate)
+			admin.GET("/users/:id/impersonation-log", middleware.RequirePermission(middleware.PermUsersRead), adminHandler.GetImpersonationAudit)
+			admin.POST("/users/:id/ban", middleware.RequirePermission(middleware.PermUsersBan), adminHandler.BanUser)
+			admin.DELETE("/users/:id/ban", middleware.RequirePermission(middleware.PermUsersBan), adminHandler.UnbanUser)
+			admin.GET("/users/:id/bans", middleware.RequirePermission(middleware.PermUsersRead), adminHandler.GetBans)
+			admin.GET("/orders", middleware.RequirePermission(middleware.PermUsersRead), adminHandler.ListOrders)
+			admin.GET("/orders/:id", middleware.RequirePermission(middleware.PermUsersRead), adminHandler.GetOrder)
+			admin.GET("/users/:id/wheel-spins", middleware.RequirePermission(middleware.PermUsersRead), adminHandler.GetWheelSpins)
+			admin.GET("/wheel/prizes", middleware.RequirePermission(middleware.PermShopManage), adminHandler.GetWheelPrizes)
+			admin.PUT("/wheel/prizes", middleware.RequirePermission(middleware.PermShopManage), adminHandler.SetWheelPrizes)
 		}
 
 		shop := api.Group("/shop")
-		shop.Use(middleware.AuthMiddleware(authClient))
+		shop.Use(middleware.AuthMiddleware(verifier, auditor), middleware.DenyDelegated())
 		{
+			shop.GET("/catalog", paymentHandler.GetCatalog)
 			shop.POST("/buy", paymentHandler.PurchaseItem)
+			shop.GET("/wheel", paymentHandler.GetWheel)
+			shop.POST("/wheel/spin", limiter.Limit("wheel_spin", 10, 1*time.Minute), paymentHandler.SpinWheel)
+			shop.GET("/orders", paymentHandler.ListOrders)
+			shop.GET("/orders/:id", paymentHandler.GetOrder)
 		}
 	}
 
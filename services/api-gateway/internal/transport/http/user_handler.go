@@ -2,11 +2,15 @@ package handlers
 
 import (
 	"api-gateway/internal/client"
+	"api-gateway/internal/middleware"
 	authpb "api-gateway/pkg/authpb/proto/auth"
 	userpb "api-gateway/pkg/userpb/proto/user"
 	"net/http"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type UserHandler struct {
@@ -22,7 +26,10 @@ func NewUserHandler(uc *client.UserClient, ac *client.AuthClient) *UserHandler {
 func (h *UserHandler) GetProfile(c *gin.Context) {
 	userID := c.GetString("userId") // Из AuthMiddleware
 
-	res, err := h.userClient.Client.GetProfile(c, &userpb.GetProfileRequest{UserId: userID})
+	res, err := h.userClient.Client.GetProfile(c, &userpb.GetProfileRequest{
+		UserId:     userID,
+		FullAccess: middleware.HasPermission(c, middleware.PermContentAll),
+	})
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "Profile not found"})
 		return
@@ -35,6 +42,7 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 	userID := c.GetString("userId")
 	var req struct {
 		Username string `json:"username"`
+		Locale   string `json:"locale"` // ru, en
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -44,6 +52,7 @@ func (h *UserHandler) UpdateProfile(c *gin.Context) {
 	_, err := h.userClient.Client.UpdateProfile(c, &userpb.UpdateProfileRequest{
 		UserId:   userID,
 		Username: req.Username,
+		Locale:   req.Locale,
 	})
 
 	if err != nil {
@@ -80,7 +89,8 @@ func (h *UserHandler) SetAvatar(c *gin.Context) {
 func (h *UserHandler) RequestEmailChange(c *gin.Context) {
 	userID := c.GetString("userId")
 	var req struct {
-		NewEmail string `json:"new_email" binding:"required,email"`
+		NewEmail      string `json:"new_email" binding:"required,email"`
+		TwoFactorCode string `json:"two_factor_code"`
 	}
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -88,9 +98,14 @@ func (h *UserHandler) RequestEmailChange(c *gin.Context) {
 	}
 
 	_, err := h.authClient.Client.RequestEmailChange(c, &authpb.RequestEmailChangeRequest{
-		UserId:   userID,
-		NewEmail: req.NewEmail,
+		UserId:        userID,
+		NewEmail:      req.NewEmail,
+		TwoFactorCode: req.TwoFactorCode,
 	})
+	if status.Code(err) == codes.ResourceExhausted {
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": status.Convert(err).Message()})
+		return
+	}
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()}) // Email занят или ошибка
 		return
@@ -128,3 +143,20 @@ func (h *UserHandler) GetLeaderboard(c *gin.Context) {
 	}
 	c.JSON(200, res.Entries)
 }
+
+// GET /api/v1/user/balance/history?limit=50&before=<next_before_id>
+func (h *UserHandler) GetBalanceHistory(c *gin.Context) {
+	limit, _ := strconv.Atoi(c.Query("limit"))
+	before, _ := strconv.ParseUint(c.Query("before"), 10, 64)
+
+	res, err := h.userClient.Client.GetBalanceHistory(c, &userpb.GetBalanceHistoryRequest{
+		UserId:   c.GetString("userId"),
+		Limit:    int32(limit),
+		BeforeId: before,
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Something went wrong"})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"entries": res.Entries, "next_before": res.NextBeforeId})
+}
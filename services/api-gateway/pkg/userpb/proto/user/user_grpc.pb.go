@@ -20,10 +20,15 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	UserService_CreateProfile_FullMethodName       = "/user.UserService/CreateProfile"
+	UserService_ListProfileIDs_FullMethodName      = "/user.UserService/ListProfileIDs"
+	UserService_DeleteProfile_FullMethodName       = "/user.UserService/DeleteProfile"
+	UserService_ExportUserData_FullMethodName      = "/user.UserService/ExportUserData"
 	UserService_GetProfile_FullMethodName          = "/user.UserService/GetProfile"
 	UserService_UpdateProfile_FullMethodName       = "/user.UserService/UpdateProfile"
 	UserService_SetAvatar_FullMethodName           = "/user.UserService/SetAvatar"
 	UserService_SyncEmail_FullMethodName           = "/user.UserService/SyncEmail"
+	UserService_SetEmailVerified_FullMethodName    = "/user.UserService/SetEmailVerified"
+	UserService_SetBanStatus_FullMethodName        = "/user.UserService/SetBanStatus"
 	UserService_StartCourse_FullMethodName         = "/user.UserService/StartCourse"
 	UserService_UpdateProgress_FullMethodName      = "/user.UserService/UpdateProgress"
 	UserService_CompleteLesson_FullMethodName      = "/user.UserService/CompleteLesson"
@@ -31,6 +36,7 @@ const (
 	UserService_SetSubscription_FullMethodName     = "/user.UserService/SetSubscription"
 	UserService_AddCourseLimit_FullMethodName      = "/user.UserService/AddCourseLimit"
 	UserService_ChangeBalance_FullMethodName       = "/user.UserService/ChangeBalance"
+	UserService_GetBalanceHistory_FullMethodName   = "/user.UserService/GetBalanceHistory"
 	UserService_UnlockAvatar_FullMethodName        = "/user.UserService/UnlockAvatar"
 	UserService_GetLeaderboard_FullMethodName      = "/user.UserService/GetLeaderboard"
 )
@@ -40,21 +46,34 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type UserServiceClient interface {
 	// Вызывается из Auth-сервиса при регистрации
+	// Идемпотентен: повторный вызов для того же user_id — успех
 	CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error)
+	// Для сверки с Auth (auth-service/cmd/reconcile)
+	ListProfileIDs(ctx context.Context, in *ListProfileIDsRequest, opts ...grpc.CallOption) (*ListProfileIDsResponse, error)
+	DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*DeleteProfileResponse, error)
+	// JSON со всем, что сервис хранит о пользователе (для выгрузки данных по запросу)
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
 	// Методы для фронтенда
 	GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error)
 	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
 	SetAvatar(ctx context.Context, in *SetAvatarRequest, opts ...grpc.CallOption) (*SetAvatarResponse, error)
 	// Если email меняется в Auth, обновляем его и здесь (для уведомлений)
 	SyncEmail(ctx context.Context, in *SyncEmailRequest, opts ...grpc.CallOption) (*SyncEmailResponse, error)
+	// Auth сообщает, что пользователь подтвердил владение почтой
+	SetEmailVerified(ctx context.Context, in *SetEmailVerifiedRequest, opts ...grpc.CallOption) (*SetEmailVerifiedResponse, error)
+	// Auth сообщает о бане и разбане: забаненных не видно в рейтинге, покупки им закрыты
+	SetBanStatus(ctx context.Context, in *SetBanStatusRequest, opts ...grpc.CallOption) (*SetBanStatusResponse, error)
 	StartCourse(ctx context.Context, in *StartCourseRequest, opts ...grpc.CallOption) (*StartCourseResponse, error)
 	UpdateProgress(ctx context.Context, in *UpdateProgressRequest, opts ...grpc.CallOption) (*UpdateProgressResponse, error)
 	CompleteLesson(ctx context.Context, in *CompleteLessonRequest, opts ...grpc.CallOption) (*CompleteLessonResponse, error)
 	GetCompletedLessons(ctx context.Context, in *GetCompletedLessonsRequest, opts ...grpc.CallOption) (*GetCompletedLessonsResponse, error)
 	SetSubscription(ctx context.Context, in *SetSubscriptionRequest, opts ...grpc.CallOption) (*SetSubscriptionResponse, error)
 	AddCourseLimit(ctx context.Context, in *AddCourseLimitRequest, opts ...grpc.CallOption) (*AddCourseLimitResponse, error)
-	// Изменить баланс (начислить или списать снежинки)
+	// Изменить баланс (начислить или списать снежинки). Каждое изменение — операция в журнале
+	// с причиной; повтор с тем же idempotency_key не меняет баланс повторно.
 	ChangeBalance(ctx context.Context, in *ChangeBalanceRequest, opts ...grpc.CallOption) (*ChangeBalanceResponse, error)
+	// История операций по балансу, новые первыми
+	GetBalanceHistory(ctx context.Context, in *GetBalanceHistoryRequest, opts ...grpc.CallOption) (*GetBalanceHistoryResponse, error)
 	// Разблокировать аватарку (выпала в колесе)
 	UnlockAvatar(ctx context.Context, in *UnlockAvatarRequest, opts ...grpc.CallOption) (*UnlockAvatarResponse, error)
 	// Получить топ студентов
@@ -79,6 +98,36 @@ func (c *userServiceClient) CreateProfile(ctx context.Context, in *CreateProfile
 	return out, nil
 }
 
+func (c *userServiceClient) ListProfileIDs(ctx context.Context, in *ListProfileIDsRequest, opts ...grpc.CallOption) (*ListProfileIDsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProfileIDsResponse)
+	err := c.cc.Invoke(ctx, UserService_ListProfileIDs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteProfile(ctx context.Context, in *DeleteProfileRequest, opts ...grpc.CallOption) (*DeleteProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_DeleteProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, UserService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) GetProfile(ctx context.Context, in *GetProfileRequest, opts ...grpc.CallOption) (*GetProfileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetProfileResponse)
@@ -119,6 +168,26 @@ func (c *userServiceClient) SyncEmail(ctx context.Context, in *SyncEmailRequest,
 	return out, nil
 }
 
+func (c *userServiceClient) SetEmailVerified(ctx context.Context, in *SetEmailVerifiedRequest, opts ...grpc.CallOption) (*SetEmailVerifiedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEmailVerifiedResponse)
+	err := c.cc.Invoke(ctx, UserService_SetEmailVerified_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetBanStatus(ctx context.Context, in *SetBanStatusRequest, opts ...grpc.CallOption) (*SetBanStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetBanStatusResponse)
+	err := c.cc.Invoke(ctx, UserService_SetBanStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) StartCourse(ctx context.Context, in *StartCourseRequest, opts ...grpc.CallOption) (*StartCourseResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StartCourseResponse)
@@ -189,6 +258,16 @@ func (c *userServiceClient) ChangeBalance(ctx context.Context, in *ChangeBalance
 	return out, nil
 }
 
+func (c *userServiceClient) GetBalanceHistory(ctx context.Context, in *GetBalanceHistoryRequest, opts ...grpc.CallOption) (*GetBalanceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBalanceHistoryResponse)
+	err := c.cc.Invoke(ctx, UserService_GetBalanceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) UnlockAvatar(ctx context.Context, in *UnlockAvatarRequest, opts ...grpc.CallOption) (*UnlockAvatarResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UnlockAvatarResponse)
@@ -214,21 +293,34 @@ func (c *userServiceClient) GetLeaderboard(ctx context.Context, in *GetLeaderboa
 // for forward compatibility.
 type UserServiceServer interface {
 	// Вызывается из Auth-сервиса при регистрации
+	// Идемпотентен: повторный вызов для того же user_id — успех
 	CreateProfile(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error)
+	// Для сверки с Auth (auth-service/cmd/reconcile)
+	ListProfileIDs(context.Context, *ListProfileIDsRequest) (*ListProfileIDsResponse, error)
+	DeleteProfile(context.Context, *DeleteProfileRequest) (*DeleteProfileResponse, error)
+	// JSON со всем, что сервис хранит о пользователе (для выгрузки данных по запросу)
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
 	// Методы для фронтенда
 	GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error)
 	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
 	SetAvatar(context.Context, *SetAvatarRequest) (*SetAvatarResponse, error)
 	// Если email меняется в Auth, обновляем его и здесь (для уведомлений)
 	SyncEmail(context.Context, *SyncEmailRequest) (*SyncEmailResponse, error)
+	// Auth сообщает, что пользователь подтвердил владение почтой
+	SetEmailVerified(context.Context, *SetEmailVerifiedRequest) (*SetEmailVerifiedResponse, error)
+	// Auth сообщает о бане и разбане: забаненных не видно в рейтинге, покупки им закрыты
+	SetBanStatus(context.Context, *SetBanStatusRequest) (*SetBanStatusResponse, error)
 	StartCourse(context.Context, *StartCourseRequest) (*StartCourseResponse, error)
 	UpdateProgress(context.Context, *UpdateProgressRequest) (*UpdateProgressResponse, error)
 	CompleteLesson(context.Context, *CompleteLessonRequest) (*CompleteLessonResponse, error)
 	GetCompletedLessons(context.Context, *GetCompletedLessonsRequest) (*GetCompletedLessonsResponse, error)
 	SetSubscription(context.Context, *SetSubscriptionRequest) (*SetSubscriptionResponse, error)
 	AddCourseLimit(context.Context, *AddCourseLimitRequest) (*AddCourseLimitResponse, error)
-	// Изменить баланс (начислить или списать снежинки)
+	// Изменить баланс (начислить или списать снежинки). Каждое изменение — операция в журнале
+	// с причиной; повтор с тем же idempotency_key не меняет баланс повторно.
 	ChangeBalance(context.Context, *ChangeBalanceRequest) (*ChangeBalanceResponse, error)
+	// История операций по балансу, новые первыми
+	GetBalanceHistory(context.Context, *GetBalanceHistoryRequest) (*GetBalanceHistoryResponse, error)
 	// Разблокировать аватарку (выпала в колесе)
 	UnlockAvatar(context.Context, *UnlockAvatarRequest) (*UnlockAvatarResponse, error)
 	// Получить топ студентов
@@ -246,6 +338,15 @@ type UnimplementedUserServiceServer struct{}
 func (UnimplementedUserServiceServer) CreateProfile(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateProfile not implemented")
 }
+func (UnimplementedUserServiceServer) ListProfileIDs(context.Context, *ListProfileIDsRequest) (*ListProfileIDsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfileIDs not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteProfile(context.Context, *DeleteProfileRequest) (*DeleteProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProfile not implemented")
+}
+func (UnimplementedUserServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
 func (UnimplementedUserServiceServer) GetProfile(context.Context, *GetProfileRequest) (*GetProfileResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetProfile not implemented")
 }
@@ -258,6 +359,12 @@ func (UnimplementedUserServiceServer) SetAvatar(context.Context, *SetAvatarReque
 func (UnimplementedUserServiceServer) SyncEmail(context.Context, *SyncEmailRequest) (*SyncEmailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SyncEmail not implemented")
 }
+func (UnimplementedUserServiceServer) SetEmailVerified(context.Context, *SetEmailVerifiedRequest) (*SetEmailVerifiedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEmailVerified not implemented")
+}
+func (UnimplementedUserServiceServer) SetBanStatus(context.Context, *SetBanStatusRequest) (*SetBanStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBanStatus not implemented")
+}
 func (UnimplementedUserServiceServer) StartCourse(context.Context, *StartCourseRequest) (*StartCourseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartCourse not implemented")
 }
@@ -279,6 +386,9 @@ func (UnimplementedUserServiceServer) AddCourseLimit(context.Context, *AddCourse
 func (UnimplementedUserServiceServer) ChangeBalance(context.Context, *ChangeBalanceRequest) (*ChangeBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeBalance not implemented")
 }
+func (UnimplementedUserServiceServer) GetBalanceHistory(context.Context, *GetBalanceHistoryRequest) (*GetBalanceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBalanceHistory not implemented")
+}
 func (UnimplementedUserServiceServer) UnlockAvatar(context.Context, *UnlockAvatarRequest) (*UnlockAvatarResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnlockAvatar not implemented")
 }
@@ -324,6 +434,60 @@ func _UserService_CreateProfile_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ListProfileIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfileIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ListProfileIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ListProfileIDs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ListProfileIDs(ctx, req.(*ListProfileIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteProfile(ctx, req.(*DeleteProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_GetProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetProfileRequest)
 	if err := dec(in); err != nil {
@@ -396,6 +560,42 @@ func _UserService_SyncEmail_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_SetEmailVerified_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEmailVerifiedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetEmailVerified(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetEmailVerified_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetEmailVerified(ctx, req.(*SetEmailVerifiedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetBanStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBanStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetBanStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetBanStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetBanStatus(ctx, req.(*SetBanStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_StartCourse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartCourseRequest)
 	if err := dec(in); err != nil {
@@ -522,6 +722,24 @@ func _UserService_ChangeBalance_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_GetBalanceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetBalanceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetBalanceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetBalanceHistory(ctx, req.(*GetBalanceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_UnlockAvatar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UnlockAvatarRequest)
 	if err := dec(in); err != nil {
@@ -569,6 +787,18 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateProfile",
 			Handler:    _UserService_CreateProfile_Handler,
 		},
+		{
+			MethodName: "ListProfileIDs",
+			Handler:    _UserService_ListProfileIDs_Handler,
+		},
+		{
+			MethodName: "DeleteProfile",
+			Handler:    _UserService_DeleteProfile_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _UserService_ExportUserData_Handler,
+		},
 		{
 			MethodName: "GetProfile",
 			Handler:    _UserService_GetProfile_Handler,
@@ -585,6 +815,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SyncEmail",
 			Handler:    _UserService_SyncEmail_Handler,
 		},
+		{
+			MethodName: "SetEmailVerified",
+			Handler:    _UserService_SetEmailVerified_Handler,
+		},
+		{
+			MethodName: "SetBanStatus",
+			Handler:    _UserService_SetBanStatus_Handler,
+		},
 		{
 			MethodName: "StartCourse",
 			Handler:    _UserService_StartCourse_Handler,
@@ -613,6 +851,10 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangeBalance",
 			Handler:    _UserService_ChangeBalance_Handler,
 		},
+		{
+			MethodName: "GetBalanceHistory",
+			Handler:    _UserService_GetBalanceHistory_Handler,
+		},
 		{
 			MethodName: "UnlockAvatar",
 			Handler:    _UserService_UnlockAvatar_Handler,
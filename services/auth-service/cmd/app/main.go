@@ -8,16 +8,20 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
 	"auth-service/config"
 	"auth-service/internal/application/usecase"
 	"auth-service/internal/domain"
 	"auth-service/internal/infrastructure/cache"
 	"auth-service/internal/infrastructure/email"
+	"auth-service/internal/infrastructure/oauth"
 	"auth-service/internal/infrastructure/repository"
 	"auth-service/internal/infrastructure/security"
 	grpc_server "auth-service/internal/transport/grpc"
+	paymentpb "auth-service/pkg/paymentpb/proto/payment"
 	userpb "auth-service/pkg/userpb/proto/user"
 
 	"github.com/redis/go-redis/v9"
@@ -41,9 +45,14 @@ func main() {
 	if err != nil {
 		log.Fatalf("Failed to connect to DB: %v", err)
 	}
-	if err := db.AutoMigrate(&domain.User{}, &domain.Device{}); err != nil {
+	hadEmailVerified := db.Migrator().HasColumn(&domain.User{}, "EmailVerifiedAt")
+	if err := db.AutoMigrate(&domain.User{}, &domain.Device{}, &domain.RecoveryCode{}, &domain.ProfileOutbox{}, &domain.ExternalIdentity{}, &domain.EmailOutbox{}, &domain.LoginEvent{}, &domain.UserRole{}, &domain.ImpersonationAudit{}, &domain.APIKey{}, &domain.Ban{}); err != nil {
 		log.Fatalf("Failed to migrate DB: %v", err)
 	}
+	// Аккаунты, зарегистрированные до подтверждения почты, считаем подтвержденными
+	if !hadEmailVerified {
+		db.Exec("UPDATE users SET email_verified_at = created_at WHERE email_verified_at IS NULL")
+	}
 
 	rdb := redis.NewClient(&redis.Options{
 		Addr: config.RedisAddr,
@@ -60,14 +69,56 @@ func main() {
 
 	userClient := userpb.NewUserServiceClient(userConn)
 
+	paymentConn, err := grpc.NewClient(config.PaymentSvcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to Payment Service: %v", err)
+	}
+	defer paymentConn.Close()
+
+	paymentClient := paymentpb.NewPaymentServiceClient(paymentConn)
+
 	userRepo := repository.NewUserRepository(db)
 	deviceRepo := repository.NewDeviceRepository(db)
-	tokenCache := cache.NewTokenCache(rdb)
-	hasher := security.NewPasswordHasher()
-	tokenManager := security.NewTokenManager(config.AccessSecret, config.RefreshSecret)
-	emailSender := email.NewEmailSender(config.APIKey, config.SMTPEmail, config.FrontendURL)
-	authUseCase := usecase.NewAuthUseCase(userRepo, tokenCache, hasher, tokenManager, emailSender, userClient, deviceRepo)
+	outboxRepo := repository.NewProfileOutboxRepository(db)
+	identityRepo := repository.NewIdentityRepository(db)
+	historyRepo := repository.NewLoginHistoryRepository(db)
+	roleRepo := repository.NewRoleRepository(db)
+	auditRepo := repository.NewImpersonationAuditRepository(db)
+	apiKeyRepo := repository.NewAPIKeyRepository(db)
+	banRepo := repository.NewBanRepository(db)
+	tokenCache := cache.NewTokenCache(rdb, config.RefreshTokenTTL, config.AccessTokenTTL)
+	hasher := passwordHasher(config)
+	policy, err := security.NewPasswordPolicy(config.PasswordMinLength, config.PasswordMaxLength, config.BreachedPasswordsFile)
+	if err != nil {
+		log.Fatalf("Failed to load breached passwords: %v", err)
+	}
+	log.Printf("Password policy: %d breached passwords loaded", policy.BreachedCount())
+	keyRing, err := security.LoadKeyRing(config.JWTKeysDir, config.JWTSigningKID)
+	if err != nil {
+		log.Fatalf("Failed to load JWT keys: %v", err)
+	}
+	tokenManager := security.NewTokenManager(keyRing, config.AccessTokenTTL, config.RefreshTokenTTL)
+	emailOutbox := repository.NewEmailOutboxRepository(db)
+	templates, err := email.NewTemplates(email.Brand{
+		Name:    config.BrandName,
+		Color:   config.BrandColor,
+		LogoURL: config.BrandLogoURL,
+	})
+	if err != nil {
+		log.Fatalf("Failed to load email templates: %v", err)
+	}
+	emailSender := email.NewEmailSender(emailOutbox, templates, config.FrontendURL)
+	mailer, err := newMailer(config)
+	if err != nil {
+		log.Fatalf("Failed to configure mail: %v", err)
+	}
+	dispatcher := email.NewDispatcher(emailOutbox, mailer)
+	totp := security.NewTOTP(config.TOTPIssuer)
+	providers, telegram := oauthProviders(config)
+	authUseCase := usecase.NewAuthUseCase(userRepo, tokenCache, hasher, policy, tokenManager, emailSender, userClient, paymentClient, deviceRepo, totp, outboxRepo,
+		identityRepo, historyRepo, roleRepo, auditRepo, apiKeyRepo, banRepo, providers, telegram, config.AccountDeletionGrace, devicePolicies(config), config.ImpersonationTTL)
 	authServer := grpc_server.NewAuthServer(authUseCase)
+	authUseCase.BootstrapAdmins(context.Background(), adminEmails(config.AdminEmails))
 
 	lis, err := net.Listen("tcp", config.GRPCPort)
 	if err != nil {
@@ -85,6 +136,17 @@ func main() {
 		}
 	}()
 
+	// Досоздание профилей, которые не удалось создать при регистрации
+	workerCtx, stopWorker := context.WithCancel(context.Background())
+	defer stopWorker()
+	go authUseCase.RunProfileOutbox(workerCtx, 10*time.Second)
+	// Отправка писем из outbox с повторами
+	go dispatcher.Run(workerCtx, 5*time.Second)
+	// Стирание аккаунтов, у которых закончился льготный период
+	go authUseCase.RunAccountPurge(workerCtx, 10*time.Minute)
+	// Удаление устройств, которыми давно не пользовались
+	go authUseCase.RunDeviceExpiry(workerCtx, time.Hour)
+
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
 	<-quit
@@ -92,3 +154,85 @@ func main() {
 	log.Println("Shutting down server...")
 	grpcServer.GracefulStop()
 }
+
+// newMailer выбирает способ доставки писем по MAIL_BACKEND
+func newMailer(cfg config.Config) (email.Mailer, error) {
+	senderName := cfg.MailSenderName
+	switch cfg.MailBackend {
+	case "sendgrid":
+		return email.NewSendGridMailer(cfg.APIKey, cfg.SMTPEmail, senderName), nil
+	case "smtp":
+		return email.NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPEmail, senderName), nil
+	case "file":
+		return email.NewFileMailer(cfg.MailFileDir, cfg.SMTPEmail, senderName), nil
+	default:
+		return nil, fmt.Errorf("unknown MAIL_BACKEND %q", cfg.MailBackend)
+	}
+}
+
+// passwordHasher: новые хэши — выбранным алгоритмом, старые проверяются вторым
+func passwordHasher(cfg config.Config) *security.PasswordHasher {
+	argon := security.NewArgon2idHasher(security.Argon2Params{
+		Memory:      cfg.Argon2Memory,
+		Iterations:  cfg.Argon2Iterations,
+		Parallelism: cfg.Argon2Parallelism,
+	})
+	bcrypt := security.NewBcryptHasher(cfg.BcryptCost)
+
+	if cfg.PasswordHashAlgo == "bcrypt" {
+		return security.NewPasswordHasher(bcrypt, argon)
+	}
+	return security.NewPasswordHasher(argon, bcrypt)
+}
+
+// oauthProviders включает провайдеров, для которых в конфиге есть ключи
+func oauthProviders(cfg config.Config) (oauth.Registry, *oauth.TelegramVerifier) {
+	redirect := func(name string) string {
+		return cfg.FrontendURL + "/oauth/" + name + "/callback"
+	}
+
+	providers := oauth.Registry{}
+	if cfg.GoogleClientID != "" {
+		providers.Add(oauth.NewOIDCProvider("google", "https://accounts.google.com",
+			cfg.GoogleClientID, cfg.GoogleClientSecret, redirect("google")))
+	}
+	if cfg.VKClientID != "" {
+		providers.Add(oauth.NewVKProvider(cfg.VKClientID, cfg.VKClientSecret, redirect("vk")))
+	}
+	if cfg.OIDCIssuer != "" {
+		providers.Add(oauth.NewOIDCProvider("oidc", cfg.OIDCIssuer,
+			cfg.OIDCClientID, cfg.OIDCClientSecret, redirect("oidc")))
+	}
+
+	var telegram *oauth.TelegramVerifier
+	if cfg.TelegramBotToken != "" {
+		telegram = oauth.NewTelegramVerifier(cfg.TelegramBotToken)
+	}
+	return providers, telegram
+}
+
+// devicePolicies собирает политики лимита устройств; опечатка в конфиге роняет старт
+func devicePolicies(cfg config.Config) usecase.DevicePolicies {
+	if !config.ValidDevicePolicy(cfg.DevicePolicy) {
+		log.Fatalf("Unknown DEVICE_POLICY %q", cfg.DevicePolicy)
+	}
+	byPlan, err := config.ParseDevicePolicies(cfg.DevicePolicies)
+	if err != nil {
+		log.Fatalf("Invalid DEVICE_POLICIES: %v", err)
+	}
+	return usecase.DevicePolicies{
+		Default:     cfg.DevicePolicy,
+		ByPlan:      byPlan,
+		InactiveTTL: cfg.DeviceInactiveTTL,
+	}
+}
+
+func adminEmails(list string) []string {
+	var emails []string
+	for _, e := range strings.Split(list, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			emails = append(emails, e)
+		}
+	}
+	return emails
+}
@@ -0,0 +1,64 @@
+// keygen создает новый ключ подписи JWT в каталоге JWT_KEYS_DIR.
+//
+// Ротация: сгенерировать ключ, перезапустить сервис (новый ключ станет активным,
+// если JWT_SIGNING_KID не задан), а старый файл удалить, когда истекут
+// выпущенные им refresh-токены.
+package main
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+func main() {
+	dir := flag.String("dir", "./keys", "каталог с ключами")
+	alg := flag.String("alg", "EdDSA", "алгоритм: EdDSA или RS256")
+	kid := flag.String("kid", time.Now().UTC().Format("20060102-150405"), "идентификатор ключа (имя файла)")
+	flag.Parse()
+
+	var der []byte
+	var err error
+	switch *alg {
+	case "EdDSA":
+		var key ed25519.PrivateKey
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+		if err == nil {
+			der, err = x509.MarshalPKCS8PrivateKey(key)
+		}
+	case "RS256":
+		var key *rsa.PrivateKey
+		key, err = rsa.GenerateKey(rand.Reader, 3072)
+		if err == nil {
+			der, err = x509.MarshalPKCS8PrivateKey(key)
+		}
+	default:
+		log.Fatalf("Unknown alg %q", *alg)
+	}
+	if err != nil {
+		log.Fatalf("Failed to generate key: %v", err)
+	}
+
+	if err := os.MkdirAll(*dir, 0o700); err != nil {
+		log.Fatalf("Failed to create %s: %v", *dir, err)
+	}
+
+	path := filepath.Join(*dir, *kid+".pem")
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		log.Fatalf("Failed to create key file: %v", err)
+	}
+	defer f.Close()
+
+	if err := pem.Encode(f, &pem.Block{Type: "PRIVATE KEY", Bytes: der}); err != nil {
+		log.Fatalf("Failed to write key: %v", err)
+	}
+	log.Printf("Created %s key %s", *alg, path)
+}
@@ -0,0 +1,100 @@
+// mailpreview собирает все письма на всех языках с тестовыми данными, чтобы посмотреть их в браузере.
+//
+//	go run ./cmd/mailpreview -out ./mail-preview
+//
+// Оформление берется из того же конфига, что и у сервиса (BRAND_*).
+package main
+
+import (
+	"flag"
+	"fmt"
+	"html/template"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"auth-service/config"
+	"auth-service/internal/infrastructure/email"
+)
+
+var index = template.Must(template.New("index").Parse(`<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Email preview {{.Version}}</title></head>
+<body style="font-family: sans-serif">
+<h2>Email templates, version {{.Version}}</h2>
+<table cellpadding="6">
+<tr><th>Письмо</th>{{range .Locales}}<th>{{.}}</th>{{end}}</tr>
+{{range $kind := .Kinds}}<tr><td>{{$kind}}</td>{{range $.Locales}}<td><a href="{{.}}/{{$kind}}.html">html</a> · <a href="{{.}}/{{$kind}}.txt">text</a></td>{{end}}</tr>
+{{end}}</table>
+</body></html>
+`))
+
+func main() {
+	out := flag.String("out", "./mail-preview", "куда сохранить письма")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig(".")
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	templates, err := email.NewTemplates(email.Brand{
+		Name:    cfg.BrandName,
+		Color:   cfg.BrandColor,
+		LogoURL: cfg.BrandLogoURL,
+	})
+	if err != nil {
+		log.Fatalf("Failed to load email templates: %v", err)
+	}
+
+	for _, locale := range templates.Locales() {
+		dir := filepath.Join(*out, locale)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			log.Fatal(err)
+		}
+		for _, kind := range email.Kinds {
+			link := fmt.Sprintf("%s/%s?token=00000000-0000-0000-0000-000000000000", cfg.FrontendURL, kind)
+			msg, err := templates.Render(kind, locale, sample(link))
+			if err != nil {
+				log.Fatalf("%s/%s: %v", locale, kind, err)
+			}
+			write(filepath.Join(dir, kind+".html"), msg.HTML)
+			write(filepath.Join(dir, kind+".txt"), "Subject: "+msg.Subject+"\n\n"+msg.Text)
+		}
+	}
+
+	f, err := os.Create(filepath.Join(*out, "index.html"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	err = index.Execute(f, map[string]interface{}{
+		"Version": templates.Version(),
+		"Locales": templates.Locales(),
+		"Kinds":   email.Kinds,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Preview of templates %s saved to %s/index.html", templates.Version(), *out)
+}
+
+func write(path, content string) {
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// sample — одинаковые данные для всех писем: каждое возьмет то, что ему нужно
+func sample(link string) email.Params {
+	return email.Params{
+		Link:  link,
+		Until: time.Now().AddDate(0, 0, 14),
+		Login: email.LoginDetails{
+			Device:    "MacBook Pro",
+			IP:        "203.0.113.7",
+			UserAgent: "Mozilla/5.0 (Macintosh; Intel Mac OS X 14_5) Chrome/126.0",
+			At:        time.Now(),
+		},
+	}
+}
@@ -0,0 +1,65 @@
+// mailq показывает письма, которые не удалось отправить (status=dead), и возвращает их в очередь.
+//
+//	go run ./cmd/mailq                 — список последних dead-писем
+//	go run ./cmd/mailq -requeue 42,43  — отправить письма заново
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"auth-service/config"
+	"auth-service/internal/infrastructure/repository"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+func main() {
+	requeue := flag.String("requeue", "", "ID писем через запятую")
+	limit := flag.Int("limit", 50, "сколько писем показать")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig(".")
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable",
+		cfg.DBHost, cfg.DBUser, cfg.DBPassword, cfg.DBName, cfg.DBPort)
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		log.Fatalf("Failed to connect to DB: %v", err)
+	}
+
+	outbox := repository.NewEmailOutboxRepository(db)
+	ctx := context.Background()
+
+	if *requeue != "" {
+		for _, s := range strings.Split(*requeue, ",") {
+			id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+			if err != nil {
+				log.Fatalf("Invalid id %q", s)
+			}
+			if err := outbox.Requeue(ctx, uint(id)); err != nil {
+				log.Fatalf("Failed to requeue %d: %v", id, err)
+			}
+			log.Printf("Email %d requeued", id)
+		}
+		return
+	}
+
+	items, err := outbox.ListDead(ctx, *limit)
+	if err != nil {
+		log.Fatalf("Failed to list dead emails: %v", err)
+	}
+	for _, item := range items {
+		fmt.Printf("%d\t%s\t%s\t%s\tattempts=%d\t%s\n",
+			item.ID, item.CreatedAt.Format("2006-01-02 15:04"), item.Kind, item.To, item.Attempts, item.LastError)
+	}
+	log.Printf("%d dead emails", len(items))
+}
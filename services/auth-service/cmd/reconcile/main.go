@@ -0,0 +1,140 @@
+// reconcile сверяет пользователей Auth с профилями User Service.
+//
+// Без флагов только печатает расхождения. С -fix досоздает недостающие профили
+// (или ставит их в outbox, если User Service не ответил). Профили без пользователя
+// в Auth удаляются только с -delete-orphan-profiles — это необратимо.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	"auth-service/config"
+	"auth-service/internal/domain"
+	"auth-service/internal/infrastructure/repository"
+	userpb "auth-service/pkg/userpb/proto/user"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+const pageSize = 500
+
+func main() {
+	fix := flag.Bool("fix", false, "досоздать недостающие профили")
+	deleteOrphans := flag.Bool("delete-orphan-profiles", false, "удалить профили, у которых нет пользователя в Auth")
+	flag.Parse()
+
+	cfg, err := config.LoadConfig(".")
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	dsn := fmt.Sprintf("host=%s user=%s password=%s dbname=%s port=%s sslmode=disable",
+		cfg.DBHost, cfg.DBUser, cfg.DBPassword, cfg.DBName, cfg.DBPort)
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	if err != nil {
+		log.Fatalf("Failed to connect to DB: %v", err)
+	}
+
+	userConn, err := grpc.NewClient(cfg.UserSvcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to User Service: %v", err)
+	}
+	defer userConn.Close()
+	userClient := userpb.NewUserServiceClient(userConn)
+
+	userRepo := repository.NewUserRepository(db)
+	outboxRepo := repository.NewProfileOutboxRepository(db)
+	ctx := context.Background()
+
+	// Сначала профили, потом пользователи: профиль создается после пользователя,
+	// так что у любого уже прочитанного профиля пользователь точно попадет во второй список.
+	profiles, err := listProfiles(ctx, userClient)
+	if err != nil {
+		log.Fatalf("Failed to list profiles: %v", err)
+	}
+	log.Printf("User Service: %d profiles", len(profiles))
+
+	var total, missing, repaired int
+	after := uuid.Nil
+	for {
+		users, err := userRepo.ListAfter(ctx, after, pageSize)
+		if err != nil {
+			log.Fatalf("Failed to list users: %v", err)
+		}
+		for _, u := range users {
+			total++
+			if _, ok := profiles[u.ID.String()]; ok {
+				delete(profiles, u.ID.String())
+				continue
+			}
+
+			missing++
+			log.Printf("MISSING PROFILE: user %s (%s)", u.ID, u.Email)
+			if *fix && repairProfile(ctx, userClient, outboxRepo, u) {
+				repaired++
+			}
+		}
+		if len(users) < pageSize {
+			break
+		}
+		after = users[len(users)-1].ID
+	}
+	log.Printf("Auth: %d users, %d without profile, %d repaired", total, missing, repaired)
+
+	// Что осталось — профили, для которых в Auth нет пользователя
+	for id := range profiles {
+		log.Printf("ORPHAN PROFILE: %s", id)
+		if !*deleteOrphans {
+			continue
+		}
+		if _, err := userClient.DeleteProfile(ctx, &userpb.DeleteProfileRequest{UserId: id}); err != nil {
+			log.Printf("Failed to delete profile %s: %v", id, err)
+		}
+	}
+	log.Printf("User Service: %d profiles without user", len(profiles))
+}
+
+func listProfiles(ctx context.Context, client userpb.UserServiceClient) (map[string]struct{}, error) {
+	ids := make(map[string]struct{})
+	token := ""
+	for {
+		res, err := client.ListProfileIDs(ctx, &userpb.ListProfileIDsRequest{PageToken: token, Limit: pageSize})
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range res.UserIds {
+			ids[id] = struct{}{}
+		}
+		if res.NextPageToken == "" {
+			return ids, nil
+		}
+		token = res.NextPageToken
+	}
+}
+
+// repairProfile создает профиль сразу, а если не вышло — оставляет задачу воркеру
+func repairProfile(ctx context.Context, client userpb.UserServiceClient, outbox *repository.ProfileOutboxRepository, u domain.User) bool {
+	_, err := client.CreateProfile(ctx, &userpb.CreateProfileRequest{
+		UserId:        u.ID.String(),
+		Email:         u.Email,
+		Username:      u.Username,
+		EmailVerified: u.EmailVerified(),
+	})
+	if err == nil {
+		_ = outbox.Delete(ctx, u.ID)
+		return true
+	}
+
+	log.Printf("Failed to create profile for %s, queueing: %v", u.ID, err)
+	if err := outbox.Enqueue(ctx, &domain.ProfileOutbox{UserID: u.ID, Email: u.Email, Username: u.Username, EmailVerified: u.EmailVerified()}); err != nil {
+		log.Printf("Failed to queue profile for %s: %v", u.ID, err)
+	}
+	return false
+}
@@ -1,24 +1,87 @@
 package config
 
 import (
+	"time"
+
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	DBHost        string `mapstructure:"DB_HOST"`
-	DBPort        string `mapstructure:"DB_PORT"`
-	DBUser        string `mapstructure:"DB_USER"`
-	DBPassword    string `mapstructure:"DB_PASSWORD"`
-	DBName        string `mapstructure:"DB_NAME"`
-	RedisAddr     string `mapstructure:"REDIS_ADDR"`
-	AccessSecret  string `mapstructure:"ACCESS_SECRET"`
-	RefreshSecret string `mapstructure:"REFRESH_SECRET"`
-	GRPCPort      string `mapstructure:"GRPC_PORT"`
-	APIKey        string `mapstructure:"API_KEY"`
-	SMTPEmail     string `mapstructure:"SMTP_EMAIL"`
-	FrontendURL   string `mapstructure:"FRONTEND_URL"`
-
-	UserSvcUrl string `mapstructure:"USER_SVC_URL"`
+	DBHost     string `mapstructure:"DB_HOST"`
+	DBPort     string `mapstructure:"DB_PORT"`
+	DBUser     string `mapstructure:"DB_USER"`
+	DBPassword string `mapstructure:"DB_PASSWORD"`
+	DBName     string `mapstructure:"DB_NAME"`
+	RedisAddr  string `mapstructure:"REDIS_ADDR"`
+	// Каталог с приватными ключами подписи (*.pem), kid = имя файла
+	JWTKeysDir    string `mapstructure:"JWT_KEYS_DIR"`
+	JWTSigningKID string `mapstructure:"JWT_SIGNING_KID"`
+
+	AccessTokenTTL  time.Duration `mapstructure:"ACCESS_TOKEN_TTL"`
+	RefreshTokenTTL time.Duration `mapstructure:"REFRESH_TOKEN_TTL"`
+	// Название сервиса в приложении-аутентификаторе
+	TOTPIssuer string `mapstructure:"TOTP_ISSUER"`
+
+	// Алгоритм для новых хэшей паролей: argon2id или bcrypt. Хэши другого алгоритма
+	// и с другими параметрами пересчитываются при входе.
+	PasswordHashAlgo  string `mapstructure:"PASSWORD_HASH_ALGO"`
+	Argon2Memory      uint32 `mapstructure:"ARGON2_MEMORY_KB"`
+	Argon2Iterations  uint32 `mapstructure:"ARGON2_ITERATIONS"`
+	Argon2Parallelism uint8  `mapstructure:"ARGON2_PARALLELISM"`
+	BcryptCost        int    `mapstructure:"BCRYPT_COST"`
+	PasswordMinLength int    `mapstructure:"PASSWORD_MIN_LENGTH"`
+	PasswordMaxLength int    `mapstructure:"PASSWORD_MAX_LENGTH"`
+	// Файл со списком утекших паролей (пароль или SHA-1 в формате HIBP на строку)
+	BreachedPasswordsFile string `mapstructure:"BREACHED_PASSWORDS_FILE"`
+
+	GRPCPort    string `mapstructure:"GRPC_PORT"`
+	APIKey      string `mapstructure:"API_KEY"`
+	SMTPEmail   string `mapstructure:"SMTP_EMAIL"`
+	FrontendURL string `mapstructure:"FRONTEND_URL"`
+
+	// Доставка писем: sendgrid (API_KEY), smtp или file (каталог MAIL_FILE_DIR, пустой — только лог).
+	// Отправитель для всех — SMTP_EMAIL.
+	MailBackend  string `mapstructure:"MAIL_BACKEND"`
+	SMTPHost     string `mapstructure:"SMTP_HOST"`
+	SMTPPort     int    `mapstructure:"SMTP_PORT"`
+	SMTPUsername string `mapstructure:"SMTP_USERNAME"`
+	SMTPPassword string `mapstructure:"SMTP_PASSWORD"`
+	MailFileDir  string `mapstructure:"MAIL_FILE_DIR"`
+	// Оформление писем
+	MailSenderName string `mapstructure:"MAIL_SENDER_NAME"`
+	BrandName      string `mapstructure:"BRAND_NAME"`
+	BrandColor     string `mapstructure:"BRAND_COLOR"`
+	BrandLogoURL   string `mapstructure:"BRAND_LOGO_URL"`
+
+	UserSvcUrl    string `mapstructure:"USER_SVC_URL"`
+	PaymentSvcUrl string `mapstructure:"PAYMENT_SVC_URL"`
+
+	// Сколько аккаунт ждет окончательного удаления; вход в этот период удаление отменяет
+	AccountDeletionGrace time.Duration `mapstructure:"ACCOUNT_DELETION_GRACE"`
+
+	// Аккаунты (через запятую), которые при старте получают роль admin. Остальные роли выдают через GrantRole.
+	AdminEmails string `mapstructure:"ADMIN_EMAILS"`
+	// Срок жизни токена «войти как пользователь» (без refresh)
+	ImpersonationTTL time.Duration `mapstructure:"IMPERSONATION_TTL"`
+
+	// Что делать при входе с нового устройства сверх лимита тарифа: reject, evict или confirm.
+	// DEVICE_POLICIES переопределяет политику для отдельных тарифов ("Обычный:reject,Стандарт:confirm").
+	DevicePolicy   string `mapstructure:"DEVICE_POLICY"`
+	DevicePolicies string `mapstructure:"DEVICE_POLICIES"`
+	// Устройство, которым не пользовались дольше, удаляется само и освобождает слот
+	DeviceInactiveTTL time.Duration `mapstructure:"DEVICE_INACTIVE_TTL"`
+
+	// Внешние провайдеры входа. Провайдер включен, если задан его client id (для Telegram — токен бота).
+	// Callback на фронтенде: FRONTEND_URL/oauth/<provider>/callback
+	GoogleClientID     string `mapstructure:"GOOGLE_CLIENT_ID"`
+	GoogleClientSecret string `mapstructure:"GOOGLE_CLIENT_SECRET"`
+	VKClientID         string `mapstructure:"VK_CLIENT_ID"`
+	VKClientSecret     string `mapstructure:"VK_CLIENT_SECRET"`
+	TelegramBotToken   string `mapstructure:"TELEGRAM_BOT_TOKEN"`
+	// Произвольный OIDC-провайдер под именем "oidc" — для локальной разработки с mock-сервером
+	OIDCIssuer       string `mapstructure:"OIDC_ISSUER"`
+	OIDCClientID     string `mapstructure:"OIDC_CLIENT_ID"`
+	OIDCClientSecret string `mapstructure:"OIDC_CLIENT_SECRET"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -28,19 +91,76 @@ func LoadConfig(path string) (config Config, err error) {
 
 	viper.AutomaticEnv()
 
+	viper.SetDefault("ACCESS_TOKEN_TTL", "15m")
+	viper.SetDefault("REFRESH_TOKEN_TTL", "168h")
+	viper.SetDefault("TOTP_ISSUER", "BazaKursov")
+	viper.SetDefault("PASSWORD_HASH_ALGO", "argon2id")
+	viper.SetDefault("ARGON2_MEMORY_KB", 64*1024)
+	viper.SetDefault("ARGON2_ITERATIONS", 3)
+	viper.SetDefault("ARGON2_PARALLELISM", 2)
+	viper.SetDefault("BCRYPT_COST", 10)
+	viper.SetDefault("PASSWORD_MIN_LENGTH", 8)
+	viper.SetDefault("PASSWORD_MAX_LENGTH", 128)
+	viper.SetDefault("BREACHED_PASSWORDS_FILE", "./breached_passwords.txt")
+	viper.SetDefault("MAIL_BACKEND", "sendgrid")
+	viper.SetDefault("SMTP_PORT", 587)
+	viper.SetDefault("MAIL_SENDER_NAME", "BazaKursov Support")
+	viper.SetDefault("BRAND_NAME", "BazaKursov")
+	viper.SetDefault("BRAND_COLOR", "#d42d2d")
+	viper.SetDefault("ACCOUNT_DELETION_GRACE", "336h")
+	viper.SetDefault("IMPERSONATION_TTL", "15m")
+	viper.SetDefault("DEVICE_POLICY", "evict")
+	viper.SetDefault("DEVICE_INACTIVE_TTL", "720h")
+
 	viper.BindEnv("DB_HOST")
 	viper.BindEnv("DB_PORT")
 	viper.BindEnv("DB_USER")
 	viper.BindEnv("DB_PASSWORD")
 	viper.BindEnv("DB_NAME")
 	viper.BindEnv("REDIS_ADDR")
-	viper.BindEnv("ACCESS_SECRET")
-	viper.BindEnv("REFRESH_SECRET")
+	viper.BindEnv("JWT_KEYS_DIR")
+	viper.BindEnv("JWT_SIGNING_KID")
+	viper.BindEnv("ACCESS_TOKEN_TTL")
+	viper.BindEnv("REFRESH_TOKEN_TTL")
+	viper.BindEnv("TOTP_ISSUER")
+	viper.BindEnv("PASSWORD_HASH_ALGO")
+	viper.BindEnv("ARGON2_MEMORY_KB")
+	viper.BindEnv("ARGON2_ITERATIONS")
+	viper.BindEnv("ARGON2_PARALLELISM")
+	viper.BindEnv("BCRYPT_COST")
+	viper.BindEnv("PASSWORD_MIN_LENGTH")
+	viper.BindEnv("PASSWORD_MAX_LENGTH")
+	viper.BindEnv("BREACHED_PASSWORDS_FILE")
 	viper.BindEnv("GRPC_PORT")
 	viper.BindEnv("API_KEY")
 	viper.BindEnv("SMTP_EMAIL")
 	viper.BindEnv("FRONTEND_URL")
+	viper.BindEnv("MAIL_BACKEND")
+	viper.BindEnv("SMTP_HOST")
+	viper.BindEnv("SMTP_PORT")
+	viper.BindEnv("SMTP_USERNAME")
+	viper.BindEnv("SMTP_PASSWORD")
+	viper.BindEnv("MAIL_FILE_DIR")
+	viper.BindEnv("MAIL_SENDER_NAME")
+	viper.BindEnv("BRAND_NAME")
+	viper.BindEnv("BRAND_COLOR")
+	viper.BindEnv("BRAND_LOGO_URL")
 	viper.BindEnv("USER_SVC_URL")
+	viper.BindEnv("PAYMENT_SVC_URL")
+	viper.BindEnv("ACCOUNT_DELETION_GRACE")
+	viper.BindEnv("ADMIN_EMAILS")
+	viper.BindEnv("IMPERSONATION_TTL")
+	viper.BindEnv("DEVICE_POLICY")
+	viper.BindEnv("DEVICE_POLICIES")
+	viper.BindEnv("DEVICE_INACTIVE_TTL")
+	viper.BindEnv("GOOGLE_CLIENT_ID")
+	viper.BindEnv("GOOGLE_CLIENT_SECRET")
+	viper.BindEnv("VK_CLIENT_ID")
+	viper.BindEnv("VK_CLIENT_SECRET")
+	viper.BindEnv("TELEGRAM_BOT_TOKEN")
+	viper.BindEnv("OIDC_ISSUER")
+	viper.BindEnv("OIDC_CLIENT_ID")
+	viper.BindEnv("OIDC_CLIENT_SECRET")
 
 	err = viper.ReadInConfig()
 	if err != nil {
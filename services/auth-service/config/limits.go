@@ -1,9 +1,38 @@
 package config
 
-var SubscriptionLimits = map[string]int{
-	"Обычный":      2,
-	"Стандарт":     4,
-	"Расширенный":  5,
-	"Персональный": 6,
-	"admin":        9999,
+import (
+	"fmt"
+	"strings"
+
+	"auth-service/internal/domain"
+)
+
+// ParseDevicePolicies разбирает DEVICE_POLICIES вида "Обычный:reject,Стандарт:confirm".
+// Тарифы, которых нет в списке, получают DEVICE_POLICY.
+func ParseDevicePolicies(s string) (map[string]string, error) {
+	policies := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		plan, policy, ok := strings.Cut(pair, ":")
+		if !ok {
+			return nil, fmt.Errorf("device policy %q: expected plan:policy", pair)
+		}
+		policy = strings.TrimSpace(policy)
+		if !ValidDevicePolicy(policy) {
+			return nil, fmt.Errorf("device policy %q: unknown policy %q", pair, policy)
+		}
+		policies[strings.TrimSpace(plan)] = policy
+	}
+	return policies, nil
+}
+
+func ValidDevicePolicy(policy string) bool {
+	switch policy {
+	case domain.DevicePolicyReject, domain.DevicePolicyEvict, domain.DevicePolicyConfirm:
+		return true
+	}
+	return false
 }
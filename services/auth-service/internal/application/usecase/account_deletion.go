@@ -0,0 +1,353 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"time"
+
+	"auth-service/internal/domain"
+	paymentpb "auth-service/pkg/paymentpb/proto/payment"
+	userpb "auth-service/pkg/userpb/proto/user"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Сколько аккаунтов стираем за один проход воркера
+const accountPurgeBatch = 20
+
+// DeleteAccountResult — либо удаление запланировано (DeleteAfter), либо ждет ссылки из письма
+type DeleteAccountResult struct {
+	ConfirmationSent bool
+	DeleteAfter      time.Time
+}
+
+// DeleteAccount начинает удаление аккаунта. Личность подтверждается паролем (и кодом 2FA, если включена);
+// у аккаунта без пароля — ссылкой из письма, тогда удаление запланирует ConfirmAccountDeletion.
+func (uc *AuthUseCase) DeleteAccount(ctx context.Context, userID, password, twoFactorCode string) (*DeleteAccountResult, error) {
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.DeletionScheduled() {
+		return nil, domain.ErrDeletionScheduled
+	}
+
+	if !user.HasPassword() {
+		token := uuid.New().String()
+		if err := uc.tokenCache.SaveAccountDeletionToken(ctx, token, user.ID.String()); err != nil {
+			return nil, err
+		}
+		if err := uc.emailSender.SendAccountDeletionConfirmation(ctx, user.Email, uc.localeOf(ctx, user.ID), token); err != nil {
+			return nil, err
+		}
+		return &DeleteAccountResult{ConfirmationSent: true}, nil
+	}
+
+	if _, err := uc.hasher.Compare(user.Password, password); err != nil {
+		return nil, domain.ErrInvalidCredentials
+	}
+	if user.TwoFactorEnabled() {
+		if err := uc.verifySecondFactor(ctx, user, twoFactorCode); err != nil {
+			return nil, err
+		}
+	}
+
+	deleteAfter, err := uc.scheduleDeletion(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return &DeleteAccountResult{DeleteAfter: deleteAfter}, nil
+}
+
+// ConfirmAccountDeletion — переход по ссылке из письма для аккаунтов без пароля
+func (uc *AuthUseCase) ConfirmAccountDeletion(ctx context.Context, token string) (time.Time, error) {
+	userID, err := uc.tokenCache.TakeAccountDeletionToken(ctx, token)
+	if err != nil {
+		return time.Time{}, domain.ErrInvalidDeletionToken
+	}
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return time.Time{}, domain.ErrInvalidDeletionToken
+	}
+	if user.DeletionScheduled() {
+		return *user.DeleteAfter, nil
+	}
+	return uc.scheduleDeletion(ctx, user)
+}
+
+// scheduleDeletion ставит дату стирания и разлогинивает пользователя везде.
+// Данные пока не трогаем: до конца льготного периода вход отменяет удаление.
+func (uc *AuthUseCase) scheduleDeletion(ctx context.Context, user *domain.User) (time.Time, error) {
+	deleteAfter := time.Now().Add(uc.deletionGrace)
+	scheduled, err := uc.userRepo.ScheduleDeletion(ctx, user.ID, deleteAfter)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !scheduled {
+		return time.Time{}, domain.ErrDeletionScheduled
+	}
+	log.Printf("[ACCOUNT] deletion of %s scheduled for %s", user.ID, deleteAfter.Format(time.RFC3339))
+
+	if err := uc.revokeAll(ctx, user.ID.String(), ""); err != nil {
+		log.Printf("Failed to revoke sessions of %s after deletion request: %v", user.ID, err)
+	}
+	if err := uc.deviceRepo.DeleteAll(ctx, user.ID); err != nil {
+		log.Printf("Failed to remove devices of %s after deletion request: %v", user.ID, err)
+	}
+	// Ключи ботов отменой удаления не восстановить — пользователь создаст новые
+	if err := uc.apiKeyRepo.DeleteAll(ctx, user.ID); err != nil {
+		log.Printf("Failed to remove api keys of %s after deletion request: %v", user.ID, err)
+	}
+
+	if err := uc.emailSender.SendAccountDeletionScheduled(ctx, user.Email, uc.localeOf(ctx, user.ID), deleteAfter); err != nil {
+		log.Printf("Failed to notify %s about account deletion: %v", user.ID, err)
+	}
+	return deleteAfter, nil
+}
+
+// cancelDeletionOnLogin: пользователь вошел в льготный период — значит, передумал.
+// Если период уже кончился, вход не состоялся: данные могли начать стираться.
+func (uc *AuthUseCase) cancelDeletionOnLogin(ctx context.Context, user *domain.User) error {
+	if !user.DeletionScheduled() {
+		return nil
+	}
+	cancelled, err := uc.userRepo.CancelDeletion(ctx, user.ID, time.Now())
+	if err != nil {
+		return err
+	}
+	if !cancelled {
+		return domain.ErrAccountDeleted
+	}
+	user.DeleteAfter = nil
+	log.Printf("[ACCOUNT] deletion of %s cancelled by login", user.ID)
+	return nil
+}
+
+// RunAccountPurge стирает аккаунты с истекшим льготным периодом. Блокируется до отмены ctx.
+// Сначала данные удаляются в других сервисах, последним — пользователь в Auth:
+// если какой-то сервис недоступен, аккаунт останется в выборке и удалится на следующем проходе.
+func (uc *AuthUseCase) RunAccountPurge(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			uc.purgeDueAccounts(ctx)
+		}
+	}
+}
+
+func (uc *AuthUseCase) purgeDueAccounts(ctx context.Context) {
+	users, err := uc.userRepo.DueForDeletion(ctx, time.Now(), accountPurgeBatch)
+	if err != nil {
+		log.Printf("Account purge: failed to load: %v", err)
+		return
+	}
+
+	for i := range users {
+		if err := uc.purgeAccount(ctx, &users[i]); err != nil {
+			log.Printf("Account purge: %s will be retried: %v", users[i].ID, err)
+			continue
+		}
+		log.Printf("[ACCOUNT] %s deleted", users[i].ID)
+	}
+}
+
+// purgeAccount идемпотентен: повторный вызов после частичного сбоя доудаляет остальное
+func (uc *AuthUseCase) purgeAccount(ctx context.Context, user *domain.User) error {
+	userID := user.ID.String()
+
+	if _, err := uc.userClient.DeleteProfile(ctx, &userpb.DeleteProfileRequest{UserId: userID}); err != nil {
+		return err
+	}
+	if _, err := uc.payClient.DeleteUserData(ctx, &paymentpb.DeleteUserDataRequest{UserId: userID}); err != nil {
+		return err
+	}
+	if err := uc.revokeAll(ctx, userID, ""); err != nil {
+		return err
+	}
+	return uc.userRepo.Purge(ctx, user, time.Now())
+}
+
+// Часть выгрузки, которую хранит Auth. Хэши паролей и API-ключей, секрет TOTP и коды восстановления не отдаем.
+type authDataExport struct {
+	ID                     uuid.UUID        `json:"id"`
+	Email                  string           `json:"email"`
+	Username               string           `json:"username"`
+	HasPassword            bool             `json:"has_password"`
+	EmailVerifiedAt        *time.Time       `json:"email_verified_at"`
+	TwoFactorEnabledAt     *time.Time       `json:"two_factor_enabled_at"`
+	RecoveryCodesRemaining int64            `json:"recovery_codes_remaining"`
+	DeleteAfter            *time.Time       `json:"delete_after"`
+	CreatedAt              time.Time        `json:"created_at"`
+	Roles                  []string         `json:"roles"`
+	APIKeys                []apiKeyExport   `json:"api_keys"`
+	Devices                []deviceExport   `json:"devices"`
+	Identities             []identityExport `json:"identities"`
+	LoginHistory           []loginExport    `json:"login_history"`
+}
+
+type deviceExport struct {
+	DeviceID     string    `json:"device_id"`
+	DeviceName   string    `json:"device_name"`
+	LastActiveAt time.Time `json:"last_active_at"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type apiKeyExport struct {
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	LastUsedIP string     `json:"last_used_ip"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type identityExport struct {
+	Provider string    `json:"provider"`
+	Email    string    `json:"email"`
+	LinkedAt time.Time `json:"linked_at"`
+}
+
+type loginExport struct {
+	At            time.Time `json:"at"`
+	Method        string    `json:"method"`
+	Success       bool      `json:"success"`
+	FailureReason string    `json:"failure_reason,omitempty"`
+	IP            string    `json:"ip"`
+	UserAgent     string    `json:"user_agent"`
+	DeviceID      string    `json:"device_id"`
+	DeviceName    string    `json:"device_name"`
+}
+
+// dataArchive — полная выгрузка: каждый сервис сам решает, как выглядит его часть
+type dataArchive struct {
+	ExportedAt time.Time       `json:"exported_at"`
+	Auth       *authDataExport `json:"auth"`
+	Profile    json.RawMessage `json:"profile"`
+	Payments   json.RawMessage `json:"payments"`
+}
+
+// ExportMyData собирает JSON-архив всего, что хранится о пользователе во всех сервисах.
+// Если какой-то сервис недоступен, выгрузка не отдается: неполный архив хуже ошибки.
+func (uc *AuthUseCase) ExportMyData(ctx context.Context, userID string) ([]byte, error) {
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	authPart, err := uc.exportAuthData(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := dataArchive{ExportedAt: time.Now().UTC(), Auth: authPart}
+
+	profile, err := uc.userClient.ExportUserData(ctx, &userpb.ExportUserDataRequest{UserId: userID})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		archive.Profile = json.RawMessage("null")
+	case err != nil:
+		return nil, err
+	default:
+		archive.Profile = profile.Data
+	}
+
+	payments, err := uc.payClient.ExportUserData(ctx, &paymentpb.ExportUserDataRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	archive.Payments = payments.Data
+
+	return json.MarshalIndent(archive, "", "  ")
+}
+
+func (uc *AuthUseCase) exportAuthData(ctx context.Context, user *domain.User) (*authDataExport, error) {
+	devices, err := uc.deviceRepo.List(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	identities, err := uc.identityRepo.ListByUser(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	codesLeft, err := uc.userRepo.CountRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	logins, err := uc.historyRepo.List(ctx, user.ID, 0)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := uc.roleRepo.Roles(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := uc.apiKeyRepo.List(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &authDataExport{
+		ID:                     user.ID,
+		Email:                  user.Email,
+		Username:               user.Username,
+		HasPassword:            user.HasPassword(),
+		EmailVerifiedAt:        user.EmailVerifiedAt,
+		TwoFactorEnabledAt:     user.TOTPEnabledAt,
+		RecoveryCodesRemaining: codesLeft,
+		DeleteAfter:            user.DeleteAfter,
+		CreatedAt:              user.CreatedAt,
+		Roles:                  roles,
+		APIKeys:                make([]apiKeyExport, 0, len(keys)),
+		Devices:                make([]deviceExport, 0, len(devices)),
+		Identities:             make([]identityExport, 0, len(identities)),
+		LoginHistory:           make([]loginExport, 0, len(logins)),
+	}
+	for _, d := range devices {
+		export.Devices = append(export.Devices, deviceExport{
+			DeviceID:     d.DeviceID,
+			DeviceName:   d.DeviceName,
+			LastActiveAt: d.LastActiveAt,
+			CreatedAt:    d.CreatedAt,
+		})
+	}
+	for _, k := range keys {
+		export.APIKeys = append(export.APIKeys, apiKeyExport{
+			Name:       k.Name,
+			Prefix:     k.Prefix,
+			Scopes:     k.Scopes,
+			ExpiresAt:  k.ExpiresAt,
+			LastUsedAt: k.LastUsedAt,
+			LastUsedIP: k.LastUsedIP,
+			CreatedAt:  k.CreatedAt,
+		})
+	}
+	for _, i := range identities {
+		export.Identities = append(export.Identities, identityExport{
+			Provider: i.Provider,
+			Email:    i.Email,
+			LinkedAt: i.CreatedAt,
+		})
+	}
+	for _, l := range logins {
+		export.LoginHistory = append(export.LoginHistory, loginExport{
+			At:            l.CreatedAt,
+			Method:        l.Method,
+			Success:       l.Success,
+			FailureReason: l.FailureReason,
+			IP:            l.IP,
+			UserAgent:     l.UserAgent,
+			DeviceID:      l.DeviceID,
+			DeviceName:    l.DeviceName,
+		})
+	}
+	return export, nil
+}
@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"auth-service/internal/domain"
+	"auth-service/internal/infrastructure/security"
+
+	"github.com/google/uuid"
+)
+
+const (
+	apiKeyNameMaxLength = 64
+	// Отметку «последнее использование» обновляем не чаще
+	apiKeyUsageInterval = time.Minute
+)
+
+// APIKeyPrincipal — владелец ключа и то, что ключу разрешено
+type APIKeyPrincipal struct {
+	UserID string
+	KeyID  string
+	Scopes []string
+	Roles  []string
+	// Права ролей владельца, выданные ключу
+	Permissions []string
+}
+
+// CreateAPIKey создает ключ и возвращает его один раз: в БД остается только хэш.
+// Без scopes ключ только читает. Права ролей можно выдать ключу, только если они есть у владельца.
+func (uc *AuthUseCase) CreateAPIKey(ctx context.Context, userID, name string, scopes []string, expiresAt *time.Time) (string, *domain.APIKey, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > apiKeyNameMaxLength {
+		return "", nil, domain.ErrAPIKeyName
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return "", nil, domain.ErrAPIKeyExpiresAt
+	}
+
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return "", nil, err
+	}
+	roles, err := uc.roleRepo.Roles(ctx, user.ID)
+	if err != nil {
+		return "", nil, err
+	}
+	scopes, err = normalizeScopes(scopes, domain.Permissions(roles))
+	if err != nil {
+		return "", nil, err
+	}
+
+	count, err := uc.apiKeyRepo.Count(ctx, user.ID)
+	if err != nil {
+		return "", nil, err
+	}
+	if count >= domain.MaxAPIKeys {
+		return "", nil, domain.ErrTooManyAPIKeys
+	}
+
+	key, prefix, hash, err := security.GenerateAPIKey()
+	if err != nil {
+		return "", nil, err
+	}
+	apiKey := &domain.APIKey{
+		UserID:    user.ID,
+		Name:      name,
+		Prefix:    prefix,
+		KeyHash:   hash,
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+	}
+	if err := uc.apiKeyRepo.Create(ctx, apiKey); err != nil {
+		return "", nil, err
+	}
+	log.Printf("[API KEYS] user %s created key %s (%s) with scopes %v", user.ID, apiKey.ID, prefix, scopes)
+	return key, apiKey, nil
+}
+
+// normalizeScopes убирает повторы и проверяет, что каждая область — read, write или право владельца
+func normalizeScopes(scopes, permissions []string) ([]string, error) {
+	if len(scopes) == 0 {
+		return []string{domain.ScopeRead}, nil
+	}
+	allowed := map[string]bool{domain.ScopeRead: true, domain.ScopeWrite: true}
+	for _, p := range permissions {
+		allowed[p] = true
+	}
+
+	seen := make(map[string]bool, len(scopes))
+	result := make([]string, 0, len(scopes))
+	for _, s := range scopes {
+		s = strings.TrimSpace(s)
+		if !allowed[s] {
+			return nil, domain.ErrAPIKeyScope
+		}
+		if !seen[s] {
+			seen[s] = true
+			result = append(result, s)
+		}
+	}
+	return result, nil
+}
+
+func (uc *AuthUseCase) ListAPIKeys(ctx context.Context, userID string) ([]domain.APIKey, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.apiKeyRepo.List(ctx, uid)
+}
+
+func (uc *AuthUseCase) RevokeAPIKey(ctx context.Context, userID, keyID string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return err
+	}
+	id, err := uuid.Parse(keyID)
+	if err != nil {
+		return domain.ErrAPIKeyNotFound
+	}
+	if err := uc.apiKeyRepo.Delete(ctx, uid, id); err != nil {
+		return err
+	}
+	log.Printf("[API KEYS] user %s revoked key %s", uid, id)
+	return nil
+}
+
+// ValidateAPIKey проверяет ключ из заголовка X-API-Key. Gateway вызывает его на каждый запрос с ключом.
+func (uc *AuthUseCase) ValidateAPIKey(ctx context.Context, key, ip string) (*APIKeyPrincipal, error) {
+	apiKey, err := uc.apiKeyRepo.GetByHash(ctx, security.HashAPIKey(key))
+	if err != nil {
+		return nil, err
+	}
+	if apiKey.Expired(time.Now()) {
+		return nil, domain.ErrInvalidAPIKey
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, apiKey.UserID)
+	if err != nil || user.DeletionScheduled() {
+		return nil, domain.ErrInvalidAPIKey
+	}
+	if err := uc.checkBan(ctx, user.ID); err != nil {
+		return nil, err
+	}
+	roles, err := uc.roleRepo.Roles(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.apiKeyRepo.MarkUsed(ctx, apiKey.ID, ip, apiKeyUsageInterval); err != nil {
+		log.Printf("Failed to mark api key %s as used: %v", apiKey.ID, err)
+	}
+	return &APIKeyPrincipal{
+		UserID:      user.ID.String(),
+		KeyID:       apiKey.ID.String(),
+		Scopes:      apiKey.Scopes,
+		Roles:       roles,
+		Permissions: apiKey.EffectivePermissions(roles),
+	}, nil
+}
@@ -3,15 +3,16 @@ package usecase
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
 	"time"
 
 	"auth-service/internal/domain"
 	"auth-service/internal/infrastructure/cache"
 	"auth-service/internal/infrastructure/email"
+	"auth-service/internal/infrastructure/oauth"
 	"auth-service/internal/infrastructure/repository"
 	"auth-service/internal/infrastructure/security"
+	paymentpb "auth-service/pkg/paymentpb/proto/payment"
 	userpb "auth-service/pkg/userpb/proto/user"
 
 	"github.com/google/uuid"
@@ -21,33 +22,98 @@ type AuthUseCase struct {
 	userRepo     *repository.UserRepository
 	tokenCache   *cache.TokenCache
 	hasher       *security.PasswordHasher
+	policy       *security.PasswordPolicy
 	tokenManager *security.TokenManager
 	emailSender  *email.EmailSender
 	userClient   userpb.UserServiceClient
+	payClient    paymentpb.PaymentServiceClient
 	deviceRepo   *repository.DeviceRepository
+	totp         *security.TOTP
+	outboxRepo   *repository.ProfileOutboxRepository
+	identityRepo *repository.IdentityRepository
+	historyRepo  *repository.LoginHistoryRepository
+	roleRepo     *repository.RoleRepository
+	auditRepo    *repository.ImpersonationAuditRepository
+	apiKeyRepo   *repository.APIKeyRepository
+	banRepo      *repository.BanRepository
+	providers    oauth.Registry
+	telegram     *oauth.TelegramVerifier // nil — вход через Telegram выключен
+	// Льготный период между удалением аккаунта и стиранием данных
+	deletionGrace time.Duration
+	devices       DevicePolicies
+	// Срок жизни токена «войти как пользователь»
+	impersonationTTL time.Duration
+}
+
+// Столько раз можно ошибиться с кодом 2FA, прежде чем придется вводить пароль заново
+const maxTwoFactorAttempts = 5
+
+// LoginResult — либо пара токенов, либо ChallengeToken, если нужен второй фактор,
+// либо DeviceChallengeToken, если нужно выбрать устройство на замену (политика confirm)
+type LoginResult struct {
+	AccessToken            string
+	RefreshToken           string
+	ChallengeToken         string
+	TwoFactorSetupRequired bool
+	DeviceChallengeToken   string
+	Devices                []domain.Device
 }
 
 func NewAuthUseCase(
 	ur *repository.UserRepository,
 	tc *cache.TokenCache,
 	h *security.PasswordHasher,
+	pp *security.PasswordPolicy,
 	tm *security.TokenManager,
 	es *email.EmailSender,
 	uc userpb.UserServiceClient,
+	pc paymentpb.PaymentServiceClient,
 	dr *repository.DeviceRepository,
+	totp *security.TOTP,
+	or *repository.ProfileOutboxRepository,
+	ir *repository.IdentityRepository,
+	lh *repository.LoginHistoryRepository,
+	rr *repository.RoleRepository,
+	ar *repository.ImpersonationAuditRepository,
+	kr *repository.APIKeyRepository,
+	br *repository.BanRepository,
+	providers oauth.Registry,
+	telegram *oauth.TelegramVerifier,
+	deletionGrace time.Duration,
+	devices DevicePolicies,
+	impersonationTTL time.Duration,
 ) *AuthUseCase {
 	return &AuthUseCase{
-		userRepo:     ur,
-		tokenCache:   tc,
-		hasher:       h,
-		tokenManager: tm,
-		emailSender:  es,
-		userClient:   uc,
-		deviceRepo:   dr,
+		userRepo:         ur,
+		tokenCache:       tc,
+		hasher:           h,
+		policy:           pp,
+		tokenManager:     tm,
+		emailSender:      es,
+		userClient:       uc,
+		payClient:        pc,
+		deviceRepo:       dr,
+		totp:             totp,
+		outboxRepo:       or,
+		identityRepo:     ir,
+		historyRepo:      lh,
+		roleRepo:         rr,
+		auditRepo:        ar,
+		apiKeyRepo:       kr,
+		banRepo:          br,
+		providers:        providers,
+		telegram:         telegram,
+		deletionGrace:    deletionGrace,
+		devices:          devices,
+		impersonationTTL: impersonationTTL,
 	}
 }
 
 func (uc *AuthUseCase) Register(ctx context.Context, username, email, password string) (string, error) {
+	if err := uc.policy.Validate(password, email, username); err != nil {
+		return "", err
+	}
+
 	hash, err := uc.hasher.Hash(password)
 	if err != nil {
 		return "", err
@@ -60,104 +126,415 @@ func (uc *AuthUseCase) Register(ctx context.Context, username, email, password s
 		Password: hash,
 	}
 
-	if err := uc.userRepo.Create(ctx, user); err != nil {
+	if err := uc.userRepo.CreateWithProfileOutbox(ctx, user, nil); err != nil {
 		return "", err
 	}
 
-	_, err = uc.userClient.CreateProfile(ctx, &userpb.CreateProfileRequest{
-		UserId:   user.ID.String(),
-		Email:    email,
-		Username: username,
-	})
-	if err != nil {
-		// Логируем ошибку, но юзера в Auth создали.
-		// В идеале: удалять юзера из Auth (Rollback)
-		log.Printf("Error creating profile: %v", err)
+	// Пробуем создать профиль сразу. Если User Service недоступен,
+	// задача уже лежит в outbox, и профиль создаст RunProfileOutbox.
+	if err := uc.createProfile(ctx, user); err != nil {
+		log.Printf("Profile for %s queued for retry: %v", user.ID, err)
+	}
+
+	if err := uc.sendVerification(ctx, user); err != nil {
+		log.Printf("Failed to start email verification for %s: %v", user.ID, err)
 	}
 
 	return user.ID.String(), nil
 }
 
-func (uc *AuthUseCase) Login(ctx context.Context, email, password, deviceID, deviceName string) (string, string, error) {
+// VerifyEmail подтверждает почту по токену из письма
+func (uc *AuthUseCase) VerifyEmail(ctx context.Context, token string) error {
+	userIDStr, err := uc.tokenCache.GetEmailVerifyToken(ctx, token)
+	if err != nil {
+		return domain.ErrInvalidVerifyToken
+	}
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return domain.ErrInvalidVerifyToken
+	}
+
+	if err := uc.markEmailVerified(ctx, userID); err != nil {
+		return err
+	}
+	uc.tokenCache.DeleteEmailVerifyToken(ctx, token)
+	return nil
+}
+
+// ResendVerification отправляет письмо с подтверждением еще раз
+func (uc *AuthUseCase) ResendVerification(ctx context.Context, userID string) error {
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.EmailVerified() {
+		return domain.ErrEmailAlreadyVerified
+	}
+	return uc.sendVerification(ctx, user)
+}
+
+func (uc *AuthUseCase) sendVerification(ctx context.Context, user *domain.User) error {
+	token := uuid.New().String()
+	if err := uc.tokenCache.SaveEmailVerifyToken(ctx, token, user.ID.String()); err != nil {
+		return err
+	}
+
+	return uc.emailSender.SendVerificationEmail(ctx, user.Email, uc.localeOf(ctx, user.ID), token)
+}
+
+// markEmailVerified сохраняет подтверждение у себя и в профиле User Service,
+// по которому Payment решает, можно ли пользователю платить
+func (uc *AuthUseCase) markEmailVerified(ctx context.Context, userID uuid.UUID) error {
+	if err := uc.userRepo.MarkEmailVerified(ctx, userID); err != nil {
+		return err
+	}
+	_, err := uc.userClient.SetEmailVerified(ctx, &userpb.SetEmailVerifiedRequest{UserId: userID.String()})
+	return err
+}
+
+// localeOf — язык писем из профиля User Service. Если профиль недоступен, пишем на языке по умолчанию:
+// письмо со ссылкой важнее языка.
+func (uc *AuthUseCase) localeOf(ctx context.Context, userID uuid.UUID) string {
+	profile, err := uc.userClient.GetProfile(ctx, &userpb.GetProfileRequest{UserId: userID.String()})
+	if err != nil || profile.Locale == "" {
+		return email.DefaultLocale
+	}
+	return profile.Locale
+}
+
+func (uc *AuthUseCase) Login(ctx context.Context, email, password string, attempt domain.LoginAttempt) (*LoginResult, error) {
+	attempt.Method = domain.LoginMethodPassword
+	account := normalizeEmail(email)
+	if err := uc.checkLoginAllowed(ctx, account, attempt.IP); err != nil {
+		// Владелец увидит в истории, что аккаунт пытались подобрать
+		if user, _ := uc.userRepo.GetByEmail(ctx, email); user != nil {
+			uc.recordLogin(ctx, user, attempt, domain.LoginFailThrottled)
+		}
+		return nil, err
+	}
+
 	user, err := uc.userRepo.GetByEmail(ctx, email)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		_ = uc.hasher.CompareDummy(password)
+		return nil, uc.loginFailed(ctx, account, attempt.IP, nil)
+	}
 	if err != nil {
-		return "", "", fmt.Errorf("Login Error: %v", err)
+		return nil, err
 	}
 
-	if err := uc.hasher.Compare(user.Password, password); err != nil {
-		return "", "", errors.New("invalid credentials")
+	// У аккаунта, созданного через Google/VK, пароля нет, пока его не зададут через восстановление
+	if !user.HasPassword() {
+		_ = uc.hasher.CompareDummy(password)
+		uc.recordLogin(ctx, user, attempt, domain.LoginFailInvalidPassword)
+		return nil, uc.loginFailed(ctx, account, attempt.IP, user)
+	}
+	rehash, err := uc.hasher.Compare(user.Password, password)
+	if err != nil {
+		uc.recordLogin(ctx, user, attempt, domain.LoginFailInvalidPassword)
+		return nil, uc.loginFailed(ctx, account, attempt.IP, user)
+	}
+	// Пароль известен только сейчас — пересчитываем хэш старого алгоритма или с устаревшими параметрами
+	if rehash {
+		uc.upgradePasswordHash(ctx, user, password)
+	}
+	if err := uc.tokenCache.ClearLoginFailures(ctx, account); err != nil {
+		log.Printf("Failed to clear login failures for %s: %v", user.ID, err)
 	}
 
-	profile, err := uc.userClient.GetProfile(ctx, &userpb.GetProfileRequest{
-		UserId: user.ID.String(),
-	})
+	return uc.completeLogin(ctx, user, attempt)
+}
+
+// upgradePasswordHash не мешает входу: не вышло сейчас — попробуем при следующем
+func (uc *AuthUseCase) upgradePasswordHash(ctx context.Context, user *domain.User, password string) {
+	hash, err := uc.hasher.Hash(password)
+	if err == nil {
+		err = uc.userRepo.UpdatePassword(ctx, user.ID, hash)
+	}
+	if err != nil {
+		log.Printf("Failed to upgrade password hash for %s: %v", user.ID, err)
+	}
+}
+
+// completeLogin — общая часть входа после проверки личности (паролем или у внешнего провайдера):
+// второй фактор, лимит устройств и выдача токенов
+func (uc *AuthUseCase) completeLogin(ctx context.Context, user *domain.User, attempt domain.LoginAttempt) (*LoginResult, error) {
+	// Проверяем до 2FA и до отмены удаления аккаунта: забаненному не выдаем даже челлендж
+	if err := uc.checkBan(ctx, user.ID); err != nil {
+		uc.recordLogin(ctx, user, attempt, domain.LoginFailBanned)
+		return nil, err
+	}
+	// Профиль в User Service уже мог быть стерт — getOrCreateProfile создал бы пустой
+	if user.DeletionDue(time.Now()) {
+		return nil, domain.ErrAccountDeleted
+	}
+
+	profile, err := uc.getOrCreateProfile(ctx, user)
+	if err != nil {
+		log.Printf("Failed to get profile for %s: %v", user.ID, err)
+		return nil, errors.New("failed to get profile")
+	}
+
+	roles, err := uc.roleRepo.Roles(ctx, user.ID)
 	if err != nil {
-		return "", "", errors.New("failed to get profile")
+		return nil, err
 	}
 
 	deviceLimit := int(profile.DeviceLimit)
+	devicePolicy := uc.devices.For(profile.SubscriptionStatus)
 
-	// Если подписка истекла (и это не админ), сбрасываем лимит до 1
+	// Если подписка истекла (и это не сотрудник), сбрасываем лимит до 1
 	// (Хотя User Service при SetSubscription уже может это регулировать, но тут доп. защита)
-	if profile.SubscriptionStatus != "admin" && profile.SubscriptionStatus != "Обычный" {
+	if !domain.Privileged(roles) && profile.SubscriptionStatus != "Обычный" {
 		// Если expires_at > 0 и текущее время > expires_at
 		if profile.ExpiresAt > 0 && time.Now().Unix() > profile.ExpiresAt {
 			deviceLimit = 1
 		}
 	}
 
-	// Передаем ЧИСЛО (limit), а не название статуса
-	err = uc.checkDeviceLimit(ctx, user.ID, deviceID, deviceName, deviceLimit)
+	// Личность подтверждена, но токены выдадим только после кода 2FA.
+	// Устройство тоже регистрируем после второго шага, иначе оно займет слот без входа.
+	if user.TwoFactorEnabled() {
+		challenge := uuid.New().String()
+		err := uc.tokenCache.SaveLoginChallenge(ctx, challenge, &domain.LoginChallenge{
+			UserID:       user.ID.String(),
+			DeviceID:     attempt.DeviceID,
+			DeviceName:   attempt.DeviceName,
+			DeviceLimit:  deviceLimit,
+			DevicePolicy: devicePolicy,
+			Method:       attempt.Method,
+			IP:           attempt.IP,
+			UserAgent:    attempt.UserAgent,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &LoginResult{ChallengeToken: challenge}, nil
+	}
+
+	res, err := uc.finishLogin(ctx, user, attempt, deviceLimit, devicePolicy, false)
 	if err != nil {
-		return "", "", err
+		return nil, err
+	}
+	// Сотрудник без 2FA войдет, но действия с правами ему недоступны, пока не включит
+	res.TwoFactorSetupRequired = domain.Privileged(roles)
+	return res, nil
+}
+
+// finishLogin — последний шаг входа: место для устройства, сессия и запись в историю.
+// Если по политике confirm нужно выбрать устройство на замену, токенов еще нет — вход продолжит ReplaceDevice.
+func (uc *AuthUseCase) finishLogin(ctx context.Context, user *domain.User, attempt domain.LoginAttempt, deviceLimit int, devicePolicy string, mfa bool) (*LoginResult, error) {
+	// Бан мог появиться, пока пользователь вводил код 2FA или выбирал устройство
+	if err := uc.checkBan(ctx, user.ID); err != nil {
+		uc.recordLogin(ctx, user, attempt, domain.LoginFailBanned)
+		return nil, err
+	}
+
+	err := uc.admitDevice(ctx, user.ID, attempt, deviceLimit, devicePolicy)
+	var limitErr *domain.DeviceLimitError
+	if errors.As(err, &limitErr) && len(limitErr.Devices) > 0 {
+		token := uuid.New().String()
+		err := uc.tokenCache.SaveDeviceChallenge(ctx, token, &domain.DeviceChallenge{
+			UserID:      user.ID.String(),
+			DeviceLimit: deviceLimit,
+			MFA:         mfa,
+			Attempt:     attempt,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &LoginResult{DeviceChallengeToken: token, Devices: limitErr.Devices}, nil
+	}
+	if err != nil {
+		uc.recordLogin(ctx, user, attempt, domain.LoginFailDeviceLimit)
+		return nil, err
 	}
 
-	return uc.generateAndSaveTokens(ctx, user.ID.String())
+	access, refresh, err := uc.startSession(ctx, user.ID.String(), attempt.DeviceID, attempt.Method, mfa)
+	if err != nil {
+		return nil, err
+	}
+	// Удаление отменяет только состоявшийся вход, а не отклоненный лимитом устройств
+	if err := uc.cancelDeletionOnLogin(ctx, user); err != nil {
+		if rErr := uc.revokeAll(ctx, user.ID.String(), ""); rErr != nil {
+			log.Printf("Failed to revoke sessions of %s after cancelled login: %v", user.ID, rErr)
+		}
+		return nil, err
+	}
+	uc.loginSucceeded(ctx, user, attempt)
+	return &LoginResult{AccessToken: access, RefreshToken: refresh}, nil
+}
+
+// VerifyTwoFactor — второй шаг входа: код из приложения или код восстановления
+func (uc *AuthUseCase) VerifyTwoFactor(ctx context.Context, challengeToken, code string) (*LoginResult, error) {
+	challenge, err := uc.tokenCache.GetLoginChallenge(ctx, challengeToken, maxTwoFactorAttempts)
+	if err != nil {
+		return nil, domain.ErrChallengeExpired
+	}
+
+	userID, err := uuid.Parse(challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	attempt := domain.LoginAttempt{
+		Method:     challenge.Method,
+		DeviceID:   challenge.DeviceID,
+		DeviceName: challenge.DeviceName,
+		IP:         challenge.IP,
+		UserAgent:  challenge.UserAgent,
+	}
+
+	if err := uc.verifySecondFactor(ctx, user, code); err != nil {
+		if errors.Is(err, domain.ErrInvalidTwoFactorCode) {
+			uc.recordLogin(ctx, user, attempt, domain.LoginFailInvalidTwoFactor)
+		}
+		return nil, err
+	}
+	uc.tokenCache.DeleteLoginChallenge(ctx, challengeToken)
+
+	return uc.finishLogin(ctx, user, attempt, challenge.DeviceLimit, challenge.DevicePolicy, true)
 }
 
 func (uc *AuthUseCase) Refresh(ctx context.Context, oldRefreshToken string) (string, string, error) {
-	userID, err := uc.tokenManager.ValidateRefreshToken(oldRefreshToken)
+	claims, err := uc.tokenManager.ParseRefreshToken(oldRefreshToken)
+	if err != nil {
+		return "", "", err
+	}
+
+	session, err := uc.tokenCache.GetSession(ctx, claims.FamilyID)
+	if err != nil || session.UserID != claims.UserID {
+		return "", "", domain.ErrSessionRevoked
+	}
+
+	// BanUser гасит сессии сразу, но сессия, начатая в момент бана, могла уцелеть
+	if uid, err := uuid.Parse(session.UserID); err == nil {
+		if err := uc.checkBan(ctx, uid); err != nil {
+			return "", "", err
+		}
+	}
+
+	// Роли читаем заново: после GrantRole/RevokeRole новые права приходят с ротацией
+	roles, err := uc.roles(ctx, session.UserID)
 	if err != nil {
 		return "", "", err
 	}
 
-	cachedID, err := uc.tokenCache.CheckRefresh(ctx, oldRefreshToken)
-	if err != nil || cachedID != userID {
-		return "", "", errors.New("token revoked")
+	next := *session
+	next.JTI = uuid.New().String()
+	access, refresh, err := uc.tokenManager.Generate(&next, roles)
+	if err != nil {
+		return "", "", err
 	}
-	// Удаляем старый
-	_ = uc.tokenCache.DeleteRefresh(ctx, oldRefreshToken)
 
-	return uc.generateAndSaveTokens(ctx, userID)
+	rotated, err := uc.tokenCache.RotateSession(ctx, session, claims.JTI, next.JTI)
+	if err != nil {
+		return "", "", err
+	}
+	if !rotated {
+		// Токен уже был ротирован — его предъявил кто-то второй.
+		// Не знаем, кто из двоих легитимный, поэтому гасим все сессии устройства.
+		log.Printf("[SECURITY] refresh token reuse detected: user=%s device=%s family=%s",
+			session.UserID, session.DeviceID, session.ID)
+		if err := uc.tokenCache.DeleteDeviceSessions(ctx, session.UserID, session.DeviceID); err != nil {
+			log.Printf("[SECURITY] failed to revoke sessions of device %s: %v", session.DeviceID, err)
+		}
+		return "", "", domain.ErrTokenReused
+	}
+
+	// Активность устройства продлевает ему жизнь: иначе оно устарело бы по DEVICE_INACTIVE_TTL
+	if uid, err := uuid.Parse(session.UserID); err == nil && session.DeviceID != "" {
+		if err := uc.deviceRepo.Touch(ctx, uid, session.DeviceID); err != nil {
+			log.Printf("Failed to touch device %s of %s: %v", session.DeviceID, session.UserID, err)
+		}
+	}
+
+	return access, refresh, nil
 }
 
-func (uc *AuthUseCase) Logout(ctx context.Context, refreshToken string, deviceID string) error {
-	log.Printf("DEBUG: Logout called. DeviceID: '%s'", deviceID)
+func (uc *AuthUseCase) Logout(ctx context.Context, refreshToken, accessToken, deviceID string) error {
+	// Access-токен отзываем сразу, иначе он проживет до истечения TTL
+	if accessToken != "" {
+		if access, err := uc.tokenManager.ValidateAccessToken(accessToken); err == nil {
+			_ = uc.tokenCache.RevokeAccessToken(ctx, access.JTI, access.ExpiresAt)
+		}
+	}
+
 	// 1. Пытаемся получить UserID из токена, чтобы удалить устройство
-	userIDStr, err := uc.tokenManager.ValidateRefreshToken(refreshToken)
+	claims, err := uc.tokenManager.ParseRefreshToken(refreshToken)
+	if err != nil {
+		return err
+	}
 
-	// Если токен валиден и передан DeviceID, удаляем устройство из БД
-	if err == nil && userIDStr != "" && deviceID != "" {
-		if uid, errParse := uuid.Parse(userIDStr); errParse == nil {
+	// Если передан DeviceID, удаляем устройство из БД
+	if deviceID != "" {
+		if uid, errParse := uuid.Parse(claims.UserID); errParse == nil {
 			_ = uc.deviceRepo.Delete(ctx, uid, deviceID)
 		}
 	}
 
-	// 2. Всегда удаляем сам токен из Redis (разлогин)
-	return uc.tokenCache.DeleteRefresh(ctx, refreshToken)
+	// 2. Всегда отзываем семейство токенов (разлогин)
+	session, err := uc.tokenCache.GetSession(ctx, claims.FamilyID)
+	if err != nil {
+		return nil
+	}
+	return uc.tokenCache.DeleteSession(ctx, session)
+}
+
+func (uc *AuthUseCase) ValidateAccess(ctx context.Context, token string) (*security.AccessClaims, error) {
+	claims, err := uc.tokenManager.ValidateAccessToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	revoked, err := uc.tokenCache.IsAccessRevoked(ctx, claims.UserID, claims.JTI, claims.IssuedAt)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, domain.ErrSessionRevoked
+	}
+	uid, err := uuid.Parse(claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkBan(ctx, uid); err != nil {
+		return nil, err
+	}
+	return claims, nil
 }
 
-func (uc *AuthUseCase) ValidateAccess(token string) (string, error) {
-	return uc.tokenManager.ValidateAccessToken(token)
+func (uc *AuthUseCase) JWKS() []security.JWK {
+	return uc.tokenManager.JWKS()
 }
 
-func (uc *AuthUseCase) generateAndSaveTokens(ctx context.Context, userID string) (string, string, error) {
-	access, refresh, err := uc.tokenManager.Generate(userID)
+// startSession начинает новое семейство refresh-токенов для пары пользователь+устройство.
+// Способ входа и mfa сохраняются в семействе, так что и после Refresh "amr" остается прежним.
+func (uc *AuthUseCase) startSession(ctx context.Context, userID, deviceID, method string, mfa bool) (string, string, error) {
+	session := &domain.Session{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		DeviceID:  deviceID,
+		JTI:       uuid.New().String(),
+		MFA:       mfa,
+		Method:    method,
+		CreatedAt: time.Now(),
+	}
+
+	roles, err := uc.roles(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+
+	access, refresh, err := uc.tokenManager.Generate(session, roles)
 	if err != nil {
 		return "", "", err
 	}
 
-	if err := uc.tokenCache.SaveRefresh(ctx, userID, refresh); err != nil {
+	if err := uc.tokenCache.SaveSession(ctx, session); err != nil {
 		return "", "", err
 	}
 	return access, refresh, nil
@@ -176,28 +553,27 @@ func (uc *AuthUseCase) ForgotPassword(ctx context.Context, email string) error {
 		return err
 	}
 
-	log.Printf("Attempting to send email to: %s", user.Email) // <-- ЛОГ 1
-
-	go func() {
-		err := uc.emailSender.SendResetEmail(user.Email, resetToken)
-		if err != nil {
-			// Этот лог покажет точную причину ошибки SMTP
-			log.Printf("ERROR: Failed to send email to %s: %v", user.Email, err)
-		} else {
-			log.Printf("SUCCESS: Email sent to %s", user.Email)
-		}
-	}()
-
-	return nil
+	// Письмо уходит через outbox: если почтовый сервис недоступен, его отправят повторно
+	return uc.emailSender.SendResetEmail(ctx, user.Email, uc.localeOf(ctx, user.ID), resetToken)
 }
 
 func (uc *AuthUseCase) ResetPassword(ctx context.Context, token, newPassword string) error {
 	userIDStr, err := uc.tokenCache.GetResetToken(ctx, token)
 	if err != nil {
-		return errors.New("invalid or expired token")
+		return domain.ErrInvalidResetToken
 	}
 
-	userID, _ := uuid.Parse(userIDStr)
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return domain.ErrInvalidResetToken
+	}
+	user, err := uc.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := uc.policy.Validate(newPassword, user.Email, user.Username); err != nil {
+		return err
+	}
 
 	hash, err := uc.hasher.Hash(newPassword)
 	if err != nil {
@@ -211,10 +587,35 @@ func (uc *AuthUseCase) ResetPassword(ctx context.Context, token, newPassword str
 	// Удаляем токен, чтобы его нельзя было использовать повторно
 	_ = uc.tokenCache.DeleteResetToken(ctx, token)
 
+	// Письмо со ссылкой дошло — значит, почта принадлежит пользователю.
+	// Так настоящий владелец адреса забирает аккаунт, который зарегистрировал кто-то другой.
+	if err := uc.markEmailVerified(ctx, userID); err != nil {
+		log.Printf("Failed to mark email verified for %s: %v", userIDStr, err)
+	}
+
+	// Пароль сменился — все открытые сессии больше не доверенные
+	if err := uc.revokeAll(ctx, userIDStr, ""); err != nil {
+		log.Printf("Failed to revoke sessions after password reset for %s: %v", userIDStr, err)
+	}
+
+	// Владелец почты сменил пароль — блокировка от перебора больше не нужна
+	_ = uc.tokenCache.ClearLoginFailures(ctx, normalizeEmail(user.Email))
+
 	return nil
 }
 
-func (uc *AuthUseCase) RequestEmailChange(ctx context.Context, userID, newEmail string) error {
+func (uc *AuthUseCase) RequestEmailChange(ctx context.Context, userID, newEmail, twoFactorCode string) error {
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	// Смена почты = смена канала восстановления пароля, поэтому просим второй фактор
+	if user.TwoFactorEnabled() {
+		if err := uc.verifySecondFactor(ctx, user, twoFactorCode); err != nil {
+			return err
+		}
+	}
+
 	// Проверка на занятость
 	exists, _ := uc.userRepo.GetByEmail(ctx, newEmail)
 	if exists != nil {
@@ -226,8 +627,7 @@ func (uc *AuthUseCase) RequestEmailChange(ctx context.Context, userID, newEmail
 		return err
 	}
 
-	go uc.emailSender.SendEmailChangeConfirmation(newEmail, token)
-	return nil
+	return uc.emailSender.SendEmailChangeConfirmation(ctx, newEmail, uc.localeOf(ctx, user.ID), token)
 }
 
 func (uc *AuthUseCase) ConfirmEmailChange(ctx context.Context, token string) error {
@@ -252,40 +652,13 @@ func (uc *AuthUseCase) ConfirmEmailChange(ctx context.Context, token string) err
 		// Тут можно вернуть ошибку, но Email в Auth уже изменен.
 	}
 
-	uc.tokenCache.DeleteEmailChangeToken(ctx, token)
-	return nil
-}
-
-// Вспомогательная функция проверки
-func (uc *AuthUseCase) checkDeviceLimit(ctx context.Context, userID uuid.UUID, deviceID, deviceName string, limit int) error {
-	// Проверяем, есть ли устройство
-	existingDevice, err := uc.deviceRepo.Find(ctx, userID, deviceID)
-
-	if err == nil {
-		return uc.deviceRepo.UpdateLastActive(ctx, existingDevice.ID)
-	}
-
-	// Устройства нет. Проверяем переданный лимит.
-	// (Удалите чтение config.SubscriptionLimits отсюда!)
-
-	currentCount, err := uc.deviceRepo.Count(ctx, userID)
-	if err != nil {
-		return err
-	}
-
-	if currentCount >= int64(limit) {
-		return fmt.Errorf("device limit (%d) reached for your subscription", limit)
+	// Ссылка пришла на новый адрес — он подтвержден
+	if err := uc.markEmailVerified(ctx, uid); err != nil {
+		log.Printf("Failed to mark email verified for %s: %v", userIDStr, err)
 	}
 
-	// Лимит не превышен — регистрируем
-	newDevice := &domain.Device{
-		UserID:       userID,
-		DeviceID:     deviceID,
-		DeviceName:   deviceName,
-		LastActiveAt: time.Now(),
-		CreatedAt:    time.Now(),
-	}
-	return uc.deviceRepo.Create(ctx, newDevice)
+	uc.tokenCache.DeleteEmailChangeToken(ctx, token)
+	return nil
 }
 
 func (uc *AuthUseCase) GetUserDevices(ctx context.Context, userID string) ([]domain.Device, error) {
@@ -301,5 +674,25 @@ func (uc *AuthUseCase) RemoveDevice(ctx context.Context, userID, deviceID string
 	if err != nil {
 		return err
 	}
-	return uc.deviceRepo.Delete(ctx, uid, deviceID)
+	if err := uc.deviceRepo.Delete(ctx, uid, deviceID); err != nil {
+		return err
+	}
+	// Без этого refresh-токен удаленного устройства жил бы еще 7 дней
+	return uc.tokenCache.DeleteDeviceSessions(ctx, userID, deviceID)
+}
+
+func (uc *AuthUseCase) RevokeAllSessions(ctx context.Context, userID, exceptDeviceID string) error {
+	if _, err := uuid.Parse(userID); err != nil {
+		return err
+	}
+	return uc.revokeAll(ctx, userID, exceptDeviceID)
+}
+
+// revokeAll гасит refresh-сессии и все уже выданные access-токены пользователя.
+// Устройство exceptDeviceID сохраняет сессию и получит новый access-токен через Refresh.
+func (uc *AuthUseCase) revokeAll(ctx context.Context, userID, exceptDeviceID string) error {
+	if err := uc.tokenCache.DeleteUserSessions(ctx, userID, exceptDeviceID); err != nil {
+		return err
+	}
+	return uc.tokenCache.RevokeTokensIssuedBefore(ctx, userID, time.Now())
 }
@@ -0,0 +1,143 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"auth-service/internal/domain"
+	userpb "auth-service/pkg/userpb/proto/user"
+
+	"github.com/google/uuid"
+)
+
+// BanUser банит пользователя: вход, обновление токенов и API-ключи перестают работать,
+// все сессии гасятся сразу. duration 0 — бессрочно. Повторный бан заменяет действующий.
+// Права модератора проверяем по базе, как и в Impersonate.
+func (uc *AuthUseCase) BanUser(ctx context.Context, actorID, userID, reason string, duration time.Duration) (*domain.Ban, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, domain.ErrBanReason
+	}
+	if duration < 0 {
+		return nil, domain.ErrBanDuration
+	}
+	if actorID == userID {
+		return nil, domain.ErrBanSelf
+	}
+
+	actor, err := uc.actorWithPermission(ctx, actorID, domain.PermUsersBan)
+	if err != nil {
+		return nil, err
+	}
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := uc.roleRepo.Roles(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	if domain.Privileged(roles) {
+		return nil, domain.ErrBanPrivileged
+	}
+
+	now := time.Now()
+	ban := &domain.Ban{
+		UserID:    user.ID,
+		Reason:    reason,
+		BannedBy:  actor.ID,
+		CreatedAt: now,
+	}
+	if duration > 0 {
+		until := now.Add(duration)
+		ban.ExpiresAt = &until
+	}
+	if err := uc.banRepo.Replace(ctx, ban); err != nil {
+		return nil, err
+	}
+	log.Printf("[BANS] %s banned %s until %v: %s", actor.ID, user.ID, ban.ExpiresAt, reason)
+
+	if err := uc.revokeAll(ctx, user.ID.String(), ""); err != nil {
+		return nil, err
+	}
+	// Бан уже действует в Auth; если User Service недоступен, повторный BanUser досинхронизирует его
+	if err := uc.syncBanStatus(ctx, user.ID, ban); err != nil {
+		return nil, err
+	}
+	return ban, nil
+}
+
+// UnbanUser снимает действующий бан досрочно
+func (uc *AuthUseCase) UnbanUser(ctx context.Context, actorID, userID string) error {
+	actor, err := uc.actorWithPermission(ctx, actorID, domain.PermUsersBan)
+	if err != nil {
+		return err
+	}
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return domain.ErrUserNotFound
+	}
+
+	lifted, err := uc.banRepo.Lift(ctx, uid, actor.ID)
+	if err != nil {
+		return err
+	}
+	if !lifted {
+		return domain.ErrNotBanned
+	}
+	log.Printf("[BANS] %s unbanned %s", actor.ID, uid)
+	return uc.syncBanStatus(ctx, uid, nil)
+}
+
+// GetBans — история банов пользователя, новые первыми
+func (uc *AuthUseCase) GetBans(ctx context.Context, userID string) ([]domain.Ban, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, domain.ErrUserNotFound
+	}
+	return uc.banRepo.List(ctx, uid)
+}
+
+// checkBan возвращает BannedError, если у пользователя действующий бан
+func (uc *AuthUseCase) checkBan(ctx context.Context, userID uuid.UUID) error {
+	ban, err := uc.banRepo.Active(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if ban != nil {
+		return ban.Error()
+	}
+	return nil
+}
+
+// syncBanStatus передает бан в User Service: там по нему скрывают из рейтинга и закрывают покупки.
+// ban nil — бан снят.
+func (uc *AuthUseCase) syncBanStatus(ctx context.Context, userID uuid.UUID, ban *domain.Ban) error {
+	req := &userpb.SetBanStatusRequest{UserId: userID.String(), Banned: ban != nil}
+	if ban != nil && ban.ExpiresAt != nil {
+		req.BannedUntil = ban.ExpiresAt.Unix()
+	}
+	_, err := uc.userClient.SetBanStatus(ctx, req)
+	if err != nil {
+		log.Printf("Failed to sync ban status of %s to user service: %v", userID, err)
+	}
+	return err
+}
+
+// actorWithPermission — сотрудник, от имени которого выполняется действие, если у него есть право perm
+func (uc *AuthUseCase) actorWithPermission(ctx context.Context, actorID, perm string) (*domain.User, error) {
+	actor, err := uc.getUser(ctx, actorID)
+	if err != nil {
+		return nil, domain.ErrPermissionDenied
+	}
+	roles, err := uc.roleRepo.Roles(ctx, actor.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !hasPermission(roles, perm) {
+		return nil, domain.ErrPermissionDenied
+	}
+	return actor, nil
+}
@@ -0,0 +1,187 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"auth-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// Сколько устаревших устройств удаляем за один проход воркера
+const deviceExpiryBatch = 200
+
+// DevicePolicies — что делать со входом сверх лимита устройств тарифа
+type DevicePolicies struct {
+	Default string
+	// Политика для отдельных тарифов (по названию подписки)
+	ByPlan map[string]string
+	// Устройство без активности дольше этого срока удаляется; 0 — не удаляется
+	InactiveTTL time.Duration
+}
+
+func (p DevicePolicies) For(plan string) string {
+	if policy, ok := p.ByPlan[plan]; ok {
+		return policy
+	}
+	return p.Default
+}
+
+// admitDevice находит место для устройства в лимите тарифа.
+// Знакомое устройство проходит всегда, новое — по политике тарифа.
+// Для политики confirm возвращает DeviceLimitError со списком устройств на выбор.
+func (uc *AuthUseCase) admitDevice(ctx context.Context, userID uuid.UUID, attempt domain.LoginAttempt, limit int, policy string) error {
+	devices, err := uc.activeDevices(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range devices {
+		if d.DeviceID == attempt.DeviceID {
+			if err := uc.deviceRepo.UpdateLastActive(ctx, d.ID); err != nil {
+				return err
+			}
+			// Лимит могли понизить, а ReconcileDevices до нас не дойти — досчитываем здесь
+			_, err := uc.trimDevices(ctx, userID, limit)
+			return err
+		}
+	}
+
+	if len(devices) >= limit {
+		switch {
+		case policy == domain.DevicePolicyEvict && limit > 0:
+			// List отдает устройства от недавних к давним — освобождаем место с конца
+			for _, d := range devices[limit-1:] {
+				if err := uc.RemoveDevice(ctx, userID.String(), d.DeviceID); err != nil {
+					return err
+				}
+				log.Printf("[DEVICES] user %s: device %s evicted for %s", userID, d.DeviceID, attempt.DeviceID)
+			}
+		case policy == domain.DevicePolicyConfirm && limit > 0:
+			return &domain.DeviceLimitError{Limit: limit, Devices: devices}
+		default:
+			return &domain.DeviceLimitError{Limit: limit}
+		}
+	}
+
+	now := time.Now()
+	return uc.deviceRepo.Create(ctx, &domain.Device{
+		UserID:       userID,
+		DeviceID:     attempt.DeviceID,
+		DeviceName:   attempt.DeviceName,
+		LastActiveAt: now,
+		CreatedAt:    now,
+	})
+}
+
+// activeDevices — устройства пользователя без устаревших; устаревшие тут же удаляются,
+// не дожидаясь воркера, чтобы не занимать слот
+func (uc *AuthUseCase) activeDevices(ctx context.Context, userID uuid.UUID) ([]domain.Device, error) {
+	devices, err := uc.deviceRepo.List(ctx, userID)
+	if err != nil || uc.devices.InactiveTTL <= 0 {
+		return devices, err
+	}
+
+	cutoff := time.Now().Add(-uc.devices.InactiveTTL)
+	active := devices[:0]
+	for _, d := range devices {
+		if d.LastActiveAt.After(cutoff) {
+			active = append(active, d)
+			continue
+		}
+		if err := uc.RemoveDevice(ctx, userID.String(), d.DeviceID); err != nil {
+			return nil, err
+		}
+		log.Printf("[DEVICES] user %s: device %s expired", userID, d.DeviceID)
+	}
+	return active, nil
+}
+
+// trimDevices оставляет limit самых недавно активных устройств, остальные отключает
+func (uc *AuthUseCase) trimDevices(ctx context.Context, userID uuid.UUID, limit int) (int, error) {
+	if limit < 0 {
+		limit = 0
+	}
+	devices, err := uc.deviceRepo.List(ctx, userID)
+	if err != nil || len(devices) <= limit {
+		return 0, err
+	}
+
+	removed := 0
+	for _, d := range devices[limit:] {
+		if err := uc.RemoveDevice(ctx, userID.String(), d.DeviceID); err != nil {
+			return removed, err
+		}
+		removed++
+		log.Printf("[DEVICES] user %s: device %s removed, limit is now %d", userID, d.DeviceID, limit)
+	}
+	return removed, nil
+}
+
+// ReconcileDevices вызывает User Service, когда тариф понизили: лишние устройства отключаются сразу
+func (uc *AuthUseCase) ReconcileDevices(ctx context.Context, userID string, limit int) (int, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return 0, err
+	}
+	return uc.trimDevices(ctx, uid, limit)
+}
+
+// ReplaceDevice завершает вход по политике confirm: выбранное устройство отключается,
+// новое занимает его место
+func (uc *AuthUseCase) ReplaceDevice(ctx context.Context, challengeToken, removeDeviceID string) (*LoginResult, error) {
+	challenge, err := uc.tokenCache.TakeDeviceChallenge(ctx, challengeToken)
+	if err != nil {
+		return nil, domain.ErrDeviceChallengeExpired
+	}
+	user, err := uc.getUser(ctx, challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if removeDeviceID != challenge.Attempt.DeviceID {
+		if err := uc.RemoveDevice(ctx, challenge.UserID, removeDeviceID); err != nil {
+			return nil, err
+		}
+		log.Printf("[DEVICES] user %s: device %s replaced by %s", user.ID, removeDeviceID, challenge.Attempt.DeviceID)
+	}
+	// Пока пользователь выбирал, слот мог занять кто-то еще — тогда просто отказываем
+	return uc.finishLogin(ctx, user, challenge.Attempt, challenge.DeviceLimit, domain.DevicePolicyReject, challenge.MFA)
+}
+
+// RunDeviceExpiry удаляет устройства, которыми не пользовались дольше InactiveTTL.
+// Блокируется до отмены ctx.
+func (uc *AuthUseCase) RunDeviceExpiry(ctx context.Context, interval time.Duration) {
+	if uc.devices.InactiveTTL <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			uc.expireDevices(ctx)
+		}
+	}
+}
+
+func (uc *AuthUseCase) expireDevices(ctx context.Context) {
+	devices, err := uc.deviceRepo.ListInactive(ctx, time.Now().Add(-uc.devices.InactiveTTL), deviceExpiryBatch)
+	if err != nil {
+		log.Printf("Device expiry: failed to load: %v", err)
+		return
+	}
+
+	for _, d := range devices {
+		if err := uc.RemoveDevice(ctx, d.UserID.String(), d.DeviceID); err != nil {
+			log.Printf("Device expiry: %s of %s will be retried: %v", d.DeviceID, d.UserID, err)
+			continue
+		}
+		log.Printf("[DEVICES] user %s: device %s expired", d.UserID, d.DeviceID)
+	}
+}
@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"auth-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// Защита от перебора паролей. Счетчик аккаунта ловит подбор пароля к одному email
+// с разных IP, счетчик IP — перебор многих аккаунтов (credential stuffing) с одного адреса.
+const (
+	loginAccountWindow = time.Hour
+	loginIPWindow      = time.Hour
+
+	// После стольких ошибок подряд начинаем задерживать попытки: 1с, 2с, 4с ... до loginMaxDelay
+	loginDelayAfter = 3
+	loginMaxDelay   = time.Minute
+
+	// После стольких ошибок аккаунт блокируется, а владельцу уходит письмо со ссылкой разблокировки
+	loginLockAfter    = 10
+	loginLockDuration = 30 * time.Minute
+
+	loginIPLimit = 100
+)
+
+// checkLoginAllowed отказывает, пока для аккаунта действует задержка или блокировка,
+// а также если с IP было слишком много ошибок. Если Redis недоступен — пропускаем,
+// как и RateLimiter в Gateway.
+func (uc *AuthUseCase) checkLoginAllowed(ctx context.Context, account, ip string) error {
+	wait, locked, err := uc.tokenCache.LoginBlockedFor(ctx, account)
+	if err != nil {
+		log.Printf("Login guard unavailable: %v", err)
+		return nil
+	}
+	if wait > 0 {
+		return &domain.LoginThrottledError{RetryAfter: wait, Locked: locked}
+	}
+
+	if ip == "" {
+		return nil
+	}
+	ipFailures, err := uc.tokenCache.LoginFailuresFromIP(ctx, ip)
+	if err != nil {
+		log.Printf("Login guard unavailable: %v", err)
+		return nil
+	}
+	if ipFailures >= loginIPLimit {
+		return &domain.LoginThrottledError{RetryAfter: loginIPWindow}
+	}
+	return nil
+}
+
+// loginFailed учитывает ошибку и всегда возвращает ErrInvalidCredentials,
+// чтобы ответ не зависел от того, существует ли аккаунт. user == nil, если email не найден.
+func (uc *AuthUseCase) loginFailed(ctx context.Context, account, ip string, user *domain.User) error {
+	failures, err := uc.tokenCache.RecordLoginFailure(ctx, account, ip, loginAccountWindow, loginIPWindow)
+	if err != nil {
+		log.Printf("Failed to record login failure: %v", err)
+		return domain.ErrInvalidCredentials
+	}
+
+	switch {
+	case failures >= loginLockAfter:
+		if err := uc.tokenCache.LockLogin(ctx, account, loginLockDuration); err != nil {
+			log.Printf("Failed to lock login for %s: %v", account, err)
+		}
+		// Письмо — только один раз за окно и только существующему пользователю
+		if failures == loginLockAfter && user != nil {
+			log.Printf("[SECURITY] account %s locked after %d failed logins (last ip %s)", user.ID, failures, ip)
+			uc.sendUnlockEmail(ctx, user, account)
+		}
+	case failures >= loginDelayAfter:
+		if err := uc.tokenCache.DelayLogin(ctx, account, loginDelay(failures)); err != nil {
+			log.Printf("Failed to delay login for %s: %v", account, err)
+		}
+	}
+	return domain.ErrInvalidCredentials
+}
+
+func loginDelay(failures int64) time.Duration {
+	d := time.Second << (failures - loginDelayAfter)
+	if d <= 0 || d > loginMaxDelay {
+		return loginMaxDelay
+	}
+	return d
+}
+
+func (uc *AuthUseCase) sendUnlockEmail(ctx context.Context, user *domain.User, account string) {
+	token := uuid.New().String()
+	if err := uc.tokenCache.SaveUnlockToken(ctx, token, account, loginLockDuration); err != nil {
+		log.Printf("Failed to save unlock token for %s: %v", user.ID, err)
+		return
+	}
+
+	if err := uc.emailSender.SendUnlockEmail(ctx, user.Email, uc.localeOf(ctx, user.ID), token); err != nil {
+		log.Printf("ERROR: Failed to queue unlock email to %s: %v", user.Email, err)
+	}
+}
+
+// UnlockAccount снимает блокировку по ссылке из письма
+func (uc *AuthUseCase) UnlockAccount(ctx context.Context, token string) error {
+	account, err := uc.tokenCache.GetUnlockToken(ctx, token)
+	if err != nil {
+		return domain.ErrInvalidUnlockToken
+	}
+	if err := uc.tokenCache.ClearLoginFailures(ctx, account); err != nil {
+		return err
+	}
+	uc.tokenCache.DeleteUnlockToken(ctx, token)
+	return nil
+}
+
+// normalizeEmail — ключ счетчиков, чтобы User@Mail.ru и user@mail.ru считались одним аккаунтом
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"auth-service/internal/domain"
+	"auth-service/internal/infrastructure/email"
+
+	"github.com/google/uuid"
+)
+
+// Сколько записей истории входов отдаем за раз
+const (
+	loginHistoryDefaultLimit = 50
+	loginHistoryMaxLimit     = 200
+)
+
+// recordLogin пишет попытку входа в историю. reason пустой — вход успешен.
+// История не должна мешать входу, поэтому ошибки только логируем.
+func (uc *AuthUseCase) recordLogin(ctx context.Context, user *domain.User, attempt domain.LoginAttempt, reason string) {
+	uc.addLoginEvent(ctx, user, attempt, reason, false)
+}
+
+func (uc *AuthUseCase) addLoginEvent(ctx context.Context, user *domain.User, attempt domain.LoginAttempt, reason string, newDevice bool) {
+	err := uc.historyRepo.Add(ctx, &domain.LoginEvent{
+		UserID:        user.ID,
+		Method:        attempt.Method,
+		Success:       reason == "",
+		FailureReason: reason,
+		IP:            attempt.IP,
+		UserAgent:     attempt.UserAgent,
+		DeviceID:      attempt.DeviceID,
+		DeviceName:    attempt.DeviceName,
+		NewDevice:     newDevice,
+	})
+	if err != nil {
+		log.Printf("Failed to record login of %s: %v", user.ID, err)
+	}
+}
+
+// loginSucceeded записывает успешный вход и, если устройство раньше не встречалось,
+// присылает письмо со ссылкой «это был не я». Самый первый вход аккаунта письма не вызывает.
+func (uc *AuthUseCase) loginSucceeded(ctx context.Context, user *domain.User, attempt domain.LoginAttempt) {
+	newDevice, err := uc.isNewDevice(ctx, user.ID, attempt.DeviceID)
+	if err != nil {
+		log.Printf("Failed to check login history of %s: %v", user.ID, err)
+	}
+	uc.addLoginEvent(ctx, user, attempt, "", newDevice)
+	if newDevice {
+		uc.sendNewDeviceAlert(ctx, user, attempt)
+	}
+}
+
+func (uc *AuthUseCase) isNewDevice(ctx context.Context, userID uuid.UUID, deviceID string) (bool, error) {
+	seenAny, err := uc.historyRepo.HasSuccessfulLogin(ctx, userID, "")
+	if err != nil || !seenAny {
+		return false, err
+	}
+	seen, err := uc.historyRepo.HasSuccessfulLogin(ctx, userID, deviceID)
+	return !seen, err
+}
+
+func (uc *AuthUseCase) sendNewDeviceAlert(ctx context.Context, user *domain.User, attempt domain.LoginAttempt) {
+	token := uuid.New().String()
+	if err := uc.tokenCache.SaveDeviceRevokeToken(ctx, token, user.ID.String(), attempt.DeviceID); err != nil {
+		log.Printf("Failed to save device revoke token for %s: %v", user.ID, err)
+		return
+	}
+	login := email.LoginDetails{
+		Device:    attempt.DeviceName,
+		IP:        attempt.IP,
+		UserAgent: attempt.UserAgent,
+		At:        time.Now().UTC(),
+	}
+	if err := uc.emailSender.SendNewDeviceAlert(ctx, user.Email, uc.localeOf(ctx, user.ID), token, login); err != nil {
+		log.Printf("Failed to queue new device alert for %s: %v", user.ID, err)
+	}
+}
+
+// GetLoginHistory — последние попытки входа, новые первыми
+func (uc *AuthUseCase) GetLoginHistory(ctx context.Context, userID string, limit int) ([]domain.LoginEvent, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = loginHistoryDefaultLimit
+	}
+	if limit > loginHistoryMaxLimit {
+		limit = loginHistoryMaxLimit
+	}
+	return uc.historyRepo.List(ctx, uid, limit)
+}
+
+// RevokeDeviceByToken — ссылка «это был не я»: устройство удаляется, его сессии гаснут
+func (uc *AuthUseCase) RevokeDeviceByToken(ctx context.Context, token string) error {
+	userID, deviceID, err := uc.tokenCache.TakeDeviceRevokeToken(ctx, token)
+	if err != nil {
+		return domain.ErrInvalidDeviceRevokeToken
+	}
+	log.Printf("[SECURITY] user %s reported login from device %s as not theirs", userID, deviceID)
+	if err := uc.RemoveDevice(ctx, userID, deviceID); err != nil {
+		return err
+	}
+	// Access-токен устройства иначе прожил бы до конца TTL; остальные устройства получат новый через Refresh
+	return uc.tokenCache.RevokeTokensIssuedBefore(ctx, userID, time.Now())
+}
@@ -0,0 +1,58 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"auth-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+// RequestMagicLink отправляет одноразовую ссылку для входа без пароля.
+// Про незнакомый email не сообщаем — как и в ForgotPassword, иначе по ответу можно перебирать адреса.
+func (uc *AuthUseCase) RequestMagicLink(ctx context.Context, email string) error {
+	user, err := uc.userRepo.GetByEmail(ctx, email)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	token := uuid.New().String()
+	if err := uc.tokenCache.SaveMagicLinkToken(ctx, token, user.ID.String()); err != nil {
+		return err
+	}
+	return uc.emailSender.SendMagicLinkEmail(ctx, user.Email, uc.localeOf(ctx, user.ID), token)
+}
+
+// LoginWithMagicLink меняет ссылку из письма на ту же пару токенов, что и Login.
+// Регистрируется устройство, на котором ссылку открыли; 2FA и лимит устройств — как при входе паролем.
+func (uc *AuthUseCase) LoginWithMagicLink(ctx context.Context, token string, attempt domain.LoginAttempt) (*LoginResult, error) {
+	attempt.Method = domain.LoginMethodMagicLink
+
+	userID, err := uc.tokenCache.TakeMagicLinkToken(ctx, token)
+	if err != nil {
+		return nil, domain.ErrInvalidMagicLink
+	}
+	user, err := uc.getUser(ctx, userID)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, domain.ErrInvalidMagicLink
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Письмо дошло — почта принадлежит пользователю
+	if !user.EmailVerified() {
+		if err := uc.markEmailVerified(ctx, user.ID); err != nil {
+			log.Printf("Failed to mark email verified for %s: %v", user.ID, err)
+		}
+	}
+	// Владелец почты вошел — блокировка от перебора пароля больше не нужна
+	_ = uc.tokenCache.ClearLoginFailures(ctx, normalizeEmail(user.Email))
+
+	return uc.completeLogin(ctx, user, attempt)
+}
@@ -0,0 +1,294 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"auth-service/internal/domain"
+	"auth-service/internal/infrastructure/oauth"
+
+	"github.com/google/uuid"
+)
+
+// OAuthResult — итог callback: вход (Login) или привязка провайдера к текущему аккаунту (Linked)
+type OAuthResult struct {
+	Login  *LoginResult
+	Linked bool
+}
+
+// StartOAuth возвращает ссылку на провайдера. linkUserID не пустой — привязка к вошедшему пользователю.
+func (uc *AuthUseCase) StartOAuth(ctx context.Context, provider, deviceID, deviceName, linkUserID string) (string, error) {
+	p, err := uc.providers.Get(provider)
+	if err != nil {
+		return "", err
+	}
+
+	verifier, challenge, err := oauth.NewVerifier()
+	if err != nil {
+		return "", err
+	}
+	state, err := oauth.RandomToken(24)
+	if err != nil {
+		return "", err
+	}
+	nonce, err := oauth.RandomToken(24)
+	if err != nil {
+		return "", err
+	}
+
+	err = uc.tokenCache.SaveOAuthState(ctx, state, &domain.OAuthState{
+		Provider:     p.Name(),
+		CodeVerifier: verifier,
+		Nonce:        nonce,
+		DeviceID:     deviceID,
+		DeviceName:   deviceName,
+		LinkUserID:   linkUserID,
+	})
+	if err != nil {
+		return "", err
+	}
+	return p.AuthURL(ctx, state, challenge, nonce)
+}
+
+// CompleteOAuth обрабатывает redirect от провайдера. vkDeviceID нужен только VK ID.
+// ip и userAgent — клиента, вернувшегося от провайдера, для истории входов.
+func (uc *AuthUseCase) CompleteOAuth(ctx context.Context, provider, state, code, vkDeviceID, ip, userAgent string) (*OAuthResult, error) {
+	st, err := uc.tokenCache.TakeOAuthState(ctx, state)
+	if err != nil {
+		return nil, domain.ErrInvalidOAuthState
+	}
+	// state выдан для другого провайдера — callback подделан или перепутан
+	if st.Provider != provider {
+		return nil, domain.ErrInvalidOAuthState
+	}
+
+	p, err := uc.providers.Get(st.Provider)
+	if err != nil {
+		return nil, err
+	}
+	identity, err := p.Exchange(ctx, oauth.Callback{Code: code, DeviceID: vkDeviceID}, st.CodeVerifier, st.Nonce)
+	if err != nil {
+		log.Printf("OAuth %s exchange failed: %v", provider, err)
+		return nil, fmt.Errorf("%w: %v", domain.ErrOAuthFailed, err)
+	}
+
+	if st.LinkUserID != "" {
+		if err := uc.linkIdentity(ctx, st.LinkUserID, identity); err != nil {
+			return nil, err
+		}
+		return &OAuthResult{Linked: true}, nil
+	}
+
+	user, err := uc.userForIdentity(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	res, err := uc.completeLogin(ctx, user, domain.LoginAttempt{
+		Method:     st.Provider,
+		DeviceID:   st.DeviceID,
+		DeviceName: st.DeviceName,
+		IP:         ip,
+		UserAgent:  userAgent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &OAuthResult{Login: res}, nil
+}
+
+// LoginWithTelegram — вход по данным Telegram Login Widget.
+// Email Telegram не отдает, поэтому войти можно только в аккаунт, к которому Telegram уже привязан.
+func (uc *AuthUseCase) LoginWithTelegram(ctx context.Context, fields map[string]string, attempt domain.LoginAttempt) (*LoginResult, error) {
+	attempt.Method = domain.LoginMethodTelegram
+
+	identity, err := uc.verifyTelegram(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	linked, err := uc.identityRepo.Find(ctx, identity.Provider, identity.Subject)
+	if errors.Is(err, domain.ErrIdentityNotFound) {
+		return nil, domain.ErrOAuthEmailRequired
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetByID(ctx, linked.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.completeLogin(ctx, user, attempt)
+}
+
+func (uc *AuthUseCase) LinkTelegram(ctx context.Context, userID string, fields map[string]string) error {
+	identity, err := uc.verifyTelegram(fields)
+	if err != nil {
+		return err
+	}
+	return uc.linkIdentity(ctx, userID, identity)
+}
+
+func (uc *AuthUseCase) verifyTelegram(fields map[string]string) (*oauth.Identity, error) {
+	if uc.telegram == nil {
+		return nil, oauth.ErrUnknownProvider
+	}
+	identity, err := uc.telegram.Verify(fields, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrOAuthFailed, err)
+	}
+	return identity, nil
+}
+
+func (uc *AuthUseCase) ListIdentities(ctx context.Context, userID string) ([]domain.ExternalIdentity, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.identityRepo.ListByUser(ctx, uid)
+}
+
+func (uc *AuthUseCase) UnlinkIdentity(ctx context.Context, userID, provider string) error {
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	identities, err := uc.identityRepo.ListByUser(ctx, user.ID)
+	if err != nil {
+		return err
+	}
+	if !user.HasPassword() && len(identities) <= 1 {
+		return domain.ErrLastLoginMethod
+	}
+	return uc.identityRepo.Delete(ctx, user.ID, provider)
+}
+
+// userForIdentity находит пользователя по внешнему аккаунту, при необходимости привязывает
+// его к существующему аккаунту по email или регистрирует нового пользователя
+func (uc *AuthUseCase) userForIdentity(ctx context.Context, identity *oauth.Identity) (*domain.User, error) {
+	linked, err := uc.identityRepo.Find(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		return uc.userRepo.GetByID(ctx, linked.UserID)
+	}
+	if !errors.Is(err, domain.ErrIdentityNotFound) {
+		return nil, err
+	}
+
+	if identity.Email == "" {
+		return nil, domain.ErrOAuthEmailRequired
+	}
+
+	user, err := uc.userRepo.GetByEmail(ctx, identity.Email)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return uc.registerFromIdentity(ctx, identity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Связываем автоматически, только если почту подтвердили обе стороны.
+	// Иначе кто-то мог бы завести у провайдера чужой email и войти в чужой аккаунт.
+	if !identity.EmailVerified || !user.EmailVerified() {
+		return nil, domain.ErrOAuthEmailConflict
+	}
+	if err := uc.linkIdentity(ctx, user.ID.String(), identity); err != nil {
+		return nil, err
+	}
+	log.Printf("[SECURITY] %s identity %s linked to user %s by verified email", identity.Provider, identity.Subject, user.ID)
+	return user, nil
+}
+
+// registerFromIdentity создает пользователя без пароля так же, как Register: через outbox профиля
+func (uc *AuthUseCase) registerFromIdentity(ctx context.Context, identity *oauth.Identity) (*domain.User, error) {
+	user := &domain.User{
+		ID:       uuid.New(),
+		Username: usernameFromEmail(identity.Email),
+		Email:    identity.Email,
+	}
+	if identity.EmailVerified {
+		now := time.Now()
+		user.EmailVerifiedAt = &now
+	}
+
+	err := uc.userRepo.CreateWithProfileOutbox(ctx, user, &domain.ExternalIdentity{
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.createProfile(ctx, user); err != nil {
+		log.Printf("Profile for %s queued for retry: %v", user.ID, err)
+	}
+	if !user.EmailVerified() {
+		if err := uc.sendVerification(ctx, user); err != nil {
+			log.Printf("Failed to start email verification for %s: %v", user.ID, err)
+		}
+	}
+	return user, nil
+}
+
+func (uc *AuthUseCase) linkIdentity(ctx context.Context, userID string, identity *oauth.Identity) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return err
+	}
+
+	linked, err := uc.identityRepo.Find(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		if linked.UserID == uid {
+			return nil
+		}
+		return domain.ErrIdentityAlreadyLinked
+	}
+	if !errors.Is(err, domain.ErrIdentityNotFound) {
+		return err
+	}
+
+	identities, err := uc.identityRepo.ListByUser(ctx, uid)
+	if err != nil {
+		return err
+	}
+	for _, i := range identities {
+		if i.Provider == identity.Provider {
+			return domain.ErrProviderAlreadyLinked
+		}
+	}
+
+	return uc.identityRepo.Create(ctx, &domain.ExternalIdentity{
+		UserID:   uid,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+		Email:    identity.Email,
+	})
+}
+
+// usernameFromEmail — локальная часть адреса плюс случайный суффикс, чтобы не пересечься с занятыми
+func usernameFromEmail(email string) string {
+	local := email
+	if i := strings.IndexByte(email, '@'); i >= 0 {
+		local = email[:i]
+	}
+
+	var b strings.Builder
+	for _, r := range local {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			b.WriteRune(r)
+		}
+		if b.Len() >= 20 {
+			break
+		}
+	}
+	name := b.String()
+	if name == "" {
+		name = "user"
+	}
+	return name + "_" + strings.ReplaceAll(uuid.NewString(), "-", "")[:6]
+}
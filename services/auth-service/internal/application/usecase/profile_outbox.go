@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"auth-service/internal/domain"
+	userpb "auth-service/pkg/userpb/proto/user"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	profileOutboxBatch = 50
+	// Повторяем через 5с, 10с, 20с ... но не реже, чем раз в 10 минут
+	profileOutboxBaseDelay = 5 * time.Second
+	profileOutboxMaxDelay  = 10 * time.Minute
+)
+
+// RunProfileOutbox досоздает профили, которые не удалось создать при регистрации.
+// Блокируется до отмены ctx. CreateProfile идемпотентен, поэтому несколько
+// реплик Auth могут обрабатывать outbox одновременно.
+func (uc *AuthUseCase) RunProfileOutbox(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			uc.processProfileOutbox(ctx)
+		}
+	}
+}
+
+func (uc *AuthUseCase) processProfileOutbox(ctx context.Context) {
+	items, err := uc.outboxRepo.Due(ctx, profileOutboxBatch)
+	if err != nil {
+		log.Printf("Profile outbox: failed to load: %v", err)
+		return
+	}
+
+	for _, item := range items {
+		user := &domain.User{ID: item.UserID, Email: item.Email, Username: item.Username}
+		if item.EmailVerified {
+			user.EmailVerifiedAt = &item.CreatedAt
+		}
+		if err := uc.createProfile(ctx, user); err != nil {
+			attempts := item.Attempts + 1
+			next := time.Now().Add(outboxDelay(attempts))
+			if err := uc.outboxRepo.Reschedule(ctx, item.ID, attempts, next, err.Error()); err != nil {
+				log.Printf("Profile outbox: failed to reschedule %s: %v", item.UserID, err)
+			}
+			log.Printf("Profile outbox: attempt %d for %s failed: %v", attempts, item.UserID, err)
+			continue
+		}
+		log.Printf("Profile outbox: profile for %s created after %d retries", item.UserID, item.Attempts)
+	}
+}
+
+func outboxDelay(attempts int) time.Duration {
+	d := profileOutboxBaseDelay << (attempts - 1)
+	if d <= 0 || d > profileOutboxMaxDelay {
+		return profileOutboxMaxDelay
+	}
+	return d
+}
+
+// createProfile создает профиль и закрывает задачу в outbox
+func (uc *AuthUseCase) createProfile(ctx context.Context, user *domain.User) error {
+	_, err := uc.userClient.CreateProfile(ctx, &userpb.CreateProfileRequest{
+		UserId:        user.ID.String(),
+		Email:         user.Email,
+		Username:      user.Username,
+		EmailVerified: user.EmailVerified(),
+	})
+	if err != nil {
+		return err
+	}
+	return uc.outboxRepo.Delete(ctx, user.ID)
+}
+
+// getOrCreateProfile чинит пользователей, оставшихся без профиля:
+// вместо вечной ошибки входа профиль создается прямо при логине.
+func (uc *AuthUseCase) getOrCreateProfile(ctx context.Context, user *domain.User) (*userpb.GetProfileResponse, error) {
+	req := &userpb.GetProfileRequest{UserId: user.ID.String()}
+	profile, err := uc.userClient.GetProfile(ctx, req)
+	if status.Code(err) != codes.NotFound {
+		return profile, err
+	}
+
+	log.Printf("Profile for %s is missing, creating on login", user.ID)
+	if err := uc.createProfile(ctx, user); err != nil {
+		return nil, err
+	}
+	return uc.userClient.GetProfile(ctx, req)
+}
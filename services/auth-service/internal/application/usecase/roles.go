@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"auth-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+func (uc *AuthUseCase) roles(ctx context.Context, userID string) ([]string, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.roleRepo.Roles(ctx, uid)
+}
+
+// GetRoles — роли пользователя и права, которые они дают
+func (uc *AuthUseCase) GetRoles(ctx context.Context, userID string) ([]string, []string, error) {
+	roles, err := uc.roles(ctx, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return roles, domain.Permissions(roles), nil
+}
+
+// GrantRole выдает роль. Право roles:manage у actorID проверяем сами, не полагаясь на Gateway.
+func (uc *AuthUseCase) GrantRole(ctx context.Context, actorID, userID, role string) ([]string, error) {
+	if !domain.ValidRole(role) {
+		return nil, domain.ErrUnknownRole
+	}
+	actor, err := uc.actorWithPermission(ctx, actorID, domain.PermRolesManage)
+	if err != nil {
+		return nil, err
+	}
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	// RoleUser есть у всех и не хранится
+	if role != domain.RoleUser {
+		granted, err := uc.roleRepo.Grant(ctx, user.ID, role, &actor.ID)
+		if err != nil {
+			return nil, err
+		}
+		if granted {
+			log.Printf("[ROLES] %s granted %s to %s", actorID, role, user.ID)
+			uc.rolesChanged(ctx, user.ID)
+		}
+	}
+	return uc.roleRepo.Roles(ctx, user.ID)
+}
+
+// RevokeRole отзывает роль. Последнего админа не отзываем, иначе выдавать роли станет некому.
+func (uc *AuthUseCase) RevokeRole(ctx context.Context, actorID, userID, role string) ([]string, error) {
+	if !domain.ValidRole(role) || role == domain.RoleUser {
+		return nil, domain.ErrUnknownRole
+	}
+	if _, err := uc.actorWithPermission(ctx, actorID, domain.PermRolesManage); err != nil {
+		return nil, err
+	}
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if role == domain.RoleAdmin {
+		admins, err := uc.roleRepo.CountWithRole(ctx, domain.RoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		roles, err := uc.roleRepo.Roles(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if admins <= 1 && hasRole(roles, domain.RoleAdmin) {
+			return nil, domain.ErrLastAdmin
+		}
+	}
+
+	revoked, err := uc.roleRepo.Revoke(ctx, user.ID, role)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		log.Printf("[ROLES] %s revoked %s from %s", actorID, role, user.ID)
+		uc.rolesChanged(ctx, user.ID)
+	}
+	return uc.roleRepo.Roles(ctx, user.ID)
+}
+
+// rolesChanged гасит выданные access-токены: с прежними правами они жили бы до конца TTL.
+// Сессии остаются — новый токен с актуальными ролями придет через Refresh.
+func (uc *AuthUseCase) rolesChanged(ctx context.Context, userID uuid.UUID) {
+	if err := uc.tokenCache.RevokeTokensIssuedBefore(ctx, userID.String(), time.Now()); err != nil {
+		log.Printf("Failed to revoke tokens of %s after role change: %v", userID, err)
+	}
+}
+
+// BootstrapAdmins выдает роль admin аккаунтам из ADMIN_EMAILS — так появляется первый админ.
+// Аккаунт должен уже существовать; отсутствующие только логируем.
+func (uc *AuthUseCase) BootstrapAdmins(ctx context.Context, emails []string) {
+	for _, e := range emails {
+		user, err := uc.userRepo.GetByEmail(ctx, e)
+		if err != nil {
+			log.Printf("Admin bootstrap: %s: %v", e, err)
+			continue
+		}
+		granted, err := uc.roleRepo.Grant(ctx, user.ID, domain.RoleAdmin, nil)
+		if err != nil {
+			log.Printf("Admin bootstrap: %s: %v", e, err)
+			continue
+		}
+		if granted {
+			log.Printf("[ROLES] admin granted to %s from ADMIN_EMAILS", user.ID)
+		}
+	}
+}
+
+func hasRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"auth-service/internal/domain"
+	"auth-service/internal/infrastructure/security"
+
+	"github.com/google/uuid"
+)
+
+// SetupTwoFactor выдает новый секрет. Он вступит в силу только после EnableTwoFactor.
+func (uc *AuthUseCase) SetupTwoFactor(ctx context.Context, userID string) (string, string, error) {
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return "", "", err
+	}
+	if user.TwoFactorEnabled() {
+		return "", "", domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := uc.totp.GenerateSecret()
+	if err != nil {
+		return "", "", err
+	}
+	if err := uc.tokenCache.SaveTOTPSetup(ctx, userID, secret); err != nil {
+		return "", "", err
+	}
+	return secret, uc.totp.URI(user.Email, secret), nil
+}
+
+// EnableTwoFactor проверяет первый код из приложения и включает 2FA.
+// Возвращает коды восстановления — больше их никто не увидит.
+func (uc *AuthUseCase) EnableTwoFactor(ctx context.Context, userID, code string) ([]string, error) {
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.TwoFactorEnabled() {
+		return nil, domain.ErrTwoFactorAlreadyEnabled
+	}
+
+	secret, err := uc.tokenCache.GetTOTPSetup(ctx, userID)
+	if err != nil {
+		return nil, domain.ErrTwoFactorSetupExpired
+	}
+	if err := uc.checkTOTP(ctx, userID, secret, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := security.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.userRepo.EnableTOTP(ctx, user.ID, secret, hashes); err != nil {
+		return nil, err
+	}
+	uc.tokenCache.DeleteTOTPSetup(ctx, userID)
+	return codes, nil
+}
+
+func (uc *AuthUseCase) DisableTwoFactor(ctx context.Context, userID, code string) error {
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !user.TwoFactorEnabled() {
+		return domain.ErrTwoFactorNotEnabled
+	}
+	if err := uc.verifySecondFactor(ctx, user, code); err != nil {
+		return err
+	}
+	return uc.userRepo.DisableTOTP(ctx, user.ID)
+}
+
+// RegenerateRecoveryCodes заменяет все старые коды новыми
+func (uc *AuthUseCase) RegenerateRecoveryCodes(ctx context.Context, userID, code string) ([]string, error) {
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.TwoFactorEnabled() {
+		return nil, domain.ErrTwoFactorNotEnabled
+	}
+	if err := uc.verifySecondFactor(ctx, user, code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := security.GenerateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.userRepo.ReplaceRecoveryCodes(ctx, user.ID, hashes); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (uc *AuthUseCase) GetTwoFactorStatus(ctx context.Context, userID string) (bool, int64, error) {
+	user, err := uc.getUser(ctx, userID)
+	if err != nil {
+		return false, 0, err
+	}
+	if !user.TwoFactorEnabled() {
+		return false, 0, nil
+	}
+	left, err := uc.userRepo.CountRecoveryCodes(ctx, user.ID)
+	return true, left, err
+}
+
+// Неверных кодов второго фактора подряд, после которых проверка закрывается на окно.
+// Без лимита 6-значный TOTP перебирается за разумное время с украденным access-токеном.
+const (
+	twoFactorMaxFailures = 5
+	twoFactorFailWindow  = 15 * time.Minute
+)
+
+// verifySecondFactor принимает код из приложения или неиспользованный код восстановления
+func (uc *AuthUseCase) verifySecondFactor(ctx context.Context, user *domain.User, code string) error {
+	userID := user.ID.String()
+	failures, err := uc.tokenCache.TwoFactorFailures(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if failures >= twoFactorMaxFailures {
+		return domain.ErrTooManyTwoFactorAttempts
+	}
+
+	if err := uc.matchSecondFactor(ctx, user, code); err != nil {
+		if errors.Is(err, domain.ErrInvalidTwoFactorCode) {
+			if recErr := uc.tokenCache.RecordTwoFactorFailure(ctx, userID, twoFactorFailWindow); recErr != nil {
+				log.Printf("Failed to record 2FA failure for %s: %v", userID, recErr)
+			}
+		}
+		return err
+	}
+	if err := uc.tokenCache.ClearTwoFactorFailures(ctx, userID); err != nil {
+		log.Printf("Failed to clear 2FA failures for %s: %v", userID, err)
+	}
+	return nil
+}
+
+func (uc *AuthUseCase) matchSecondFactor(ctx context.Context, user *domain.User, code string) error {
+	if code == "" {
+		return domain.ErrInvalidTwoFactorCode
+	}
+	if err := uc.checkTOTP(ctx, user.ID.String(), user.TOTPSecret, code); err == nil {
+		return nil
+	}
+
+	ok, err := uc.userRepo.UseRecoveryCode(ctx, user.ID, security.HashRecoveryCode(code))
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.ErrInvalidTwoFactorCode
+	}
+	return nil
+}
+
+// checkTOTP сверяет код и сжигает его шаг, чтобы подсмотренный код нельзя было повторить
+func (uc *AuthUseCase) checkTOTP(ctx context.Context, userID, secret, code string) error {
+	step, ok := uc.totp.Validate(secret, code, time.Now())
+	if !ok {
+		return domain.ErrInvalidTwoFactorCode
+	}
+	fresh, err := uc.tokenCache.MarkTOTPStepUsed(ctx, userID, step)
+	if err != nil {
+		return err
+	}
+	if !fresh {
+		return domain.ErrInvalidTwoFactorCode
+	}
+	return nil
+}
+
+func (uc *AuthUseCase) getUser(ctx context.Context, userID string) (*domain.User, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.userRepo.GetByID(ctx, uid)
+}
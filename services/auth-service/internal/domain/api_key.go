@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Базовые области действия ключа; кроме них ключу можно выдать права ролей владельца
+const (
+	// GET-запросы от имени владельца
+	ScopeRead = "read"
+	// Все остальные запросы
+	ScopeWrite = "write"
+)
+
+// Столько активных ключей может быть у одного пользователя
+const MaxAPIKeys = 10
+
+var (
+	ErrInvalidAPIKey   = errors.New("invalid or expired api key")
+	ErrAPIKeyNotFound  = errors.New("api key not found")
+	ErrAPIKeyName      = errors.New("api key name is required")
+	ErrAPIKeyScope     = errors.New("unknown or not permitted api key scope")
+	ErrTooManyAPIKeys  = errors.New("too many api keys")
+	ErrAPIKeyExpiresAt = errors.New("api key expiry must be in the future")
+)
+
+// APIKey — ключ для ботов и скриптов. Хранится только хэш; сам ключ показывается один раз при создании.
+type APIKey struct {
+	ID     uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID uuid.UUID `gorm:"type:uuid;index;not null"`
+	Name   string    `gorm:"not null"`
+	// Начало ключа — чтобы пользователь узнал его в списке
+	Prefix  string   `gorm:"not null"`
+	KeyHash string   `gorm:"uniqueIndex;not null"`
+	Scopes  []string `gorm:"serializer:json"`
+	// nil — бессрочный
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	LastUsedIP string
+	CreatedAt  time.Time
+}
+
+func (k *APIKey) Expired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// EffectivePermissions — права ролей владельца, оставленные ключу. Роли могли отозвать после
+// создания ключа, поэтому считаем на каждом запросе.
+func (k *APIKey) EffectivePermissions(roles []string) []string {
+	granted := make(map[string]bool, len(k.Scopes))
+	for _, s := range k.Scopes {
+		granted[s] = true
+	}
+	perms := []string{}
+	for _, p := range Permissions(roles) {
+		if granted[p] {
+			perms = append(perms, p)
+		}
+	}
+	return perms
+}
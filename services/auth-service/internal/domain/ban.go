@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrBanReason = errors.New("ban reason is required")
+	ErrBanSelf   = errors.New("cannot ban yourself")
+	// Срок бана: 0 — бессрочный
+	ErrBanDuration = errors.New("ban duration must not be negative")
+	// Сначала нужно снять роли — иначе бан обходится правами сотрудника
+	ErrBanPrivileged = errors.New("cannot ban staff accounts")
+	ErrNotBanned     = errors.New("user is not banned")
+)
+
+// BannedError — вход и обновление токенов запрещены, пока действует бан
+type BannedError struct {
+	Reason string
+	Until  *time.Time // nil — навсегда
+}
+
+func (e *BannedError) Error() string {
+	if e.Until == nil {
+		return fmt.Sprintf("account is banned permanently: %s", e.Reason)
+	}
+	return fmt.Sprintf("account is banned until %s: %s", e.Until.UTC().Format(time.RFC3339), e.Reason)
+}
+
+// Ban — бан аккаунта модератором. Записи не удаляются при снятии бана:
+// история нужна, чтобы видеть повторных нарушителей.
+type Ban struct {
+	ID       uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	UserID   uuid.UUID `gorm:"type:uuid;index;not null"`
+	Reason   string    `gorm:"not null"`
+	BannedBy uuid.UUID `gorm:"type:uuid;not null"`
+	// nil — бессрочный
+	ExpiresAt *time.Time
+	// Снят досрочно (разбан или замена новым баном)
+	LiftedAt  *time.Time
+	LiftedBy  *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt time.Time
+}
+
+func (b *Ban) Active(now time.Time) bool {
+	return b.LiftedAt == nil && (b.ExpiresAt == nil || b.ExpiresAt.After(now))
+}
+
+func (b *Ban) Error() error {
+	return &BannedError{Reason: b.Reason, Until: b.ExpiresAt}
+}
@@ -1,11 +1,25 @@
 package domain
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// Что делать, когда устройств уже столько, сколько позволяет тариф
+const (
+	// Отказать во входе, пока пользователь сам не удалит устройство
+	DevicePolicyReject = "reject"
+	// Молча удалить устройство, которым дольше всех не пользовались
+	DevicePolicyEvict = "evict"
+	// Показать список устройств и дать выбрать, какое отключить (ReplaceDevice)
+	DevicePolicyConfirm = "confirm"
+)
+
+var ErrDeviceChallengeExpired = errors.New("device confirmation expired, log in again")
+
 type Device struct {
 	ID           uint      `gorm:"primaryKey"`
 	UserID       uuid.UUID `gorm:"index;type:uuid"`
@@ -14,3 +28,22 @@ type Device struct {
 	LastActiveAt time.Time
 	CreatedAt    time.Time
 }
+
+// DeviceLimitError — новое устройство не помещается в лимит тарифа
+type DeviceLimitError struct {
+	Limit int
+	// Политика confirm: можно выбрать устройство на замену
+	Devices []Device
+}
+
+func (e *DeviceLimitError) Error() string {
+	return fmt.Sprintf("device limit (%d) reached for your subscription", e.Limit)
+}
+
+// DeviceChallenge — вход подтвержден, но ждет выбора устройства, которое нужно отключить
+type DeviceChallenge struct {
+	UserID      string
+	DeviceLimit int
+	MFA         bool
+	Attempt     LoginAttempt
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+const (
+	EmailPending = "pending"
+	EmailSent    = "sent"
+	// Исчерпаны попытки — письмо больше не отправляется, ждет разбора
+	EmailDead = "dead"
+)
+
+// EmailOutbox — письмо в очереди на отправку. Пишется сразу, отправляется воркером,
+// поэтому письма переживают рестарт сервиса и недоступность почтового провайдера.
+type EmailOutbox struct {
+	ID   uint   `gorm:"primaryKey"`
+	Kind string // reset_password, verify_email, ... — для логов и разбора dead-писем
+	// Версия шаблонов, которыми собрано письмо
+	TemplateVersion string
+	To              string `gorm:"column:to_email;not null"`
+	Subject         string `gorm:"not null"`
+	HTML            string `gorm:"column:html;type:text;not null"`
+	Text            string `gorm:"type:text"`
+	Status          string `gorm:"index;not null"`
+	Attempts        int
+	NextAttemptAt   time.Time `gorm:"index"`
+	LastError       string
+	SentAt          *time.Time
+	CreatedAt       time.Time
+}
@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidOAuthState = errors.New("invalid or expired oauth state")
+	// Провайдер отклонил код или вернул некорректные данные
+	ErrOAuthFailed = errors.New("identity provider authentication failed")
+	// Провайдер не дал email, а привязанного аккаунта нет — создать пользователя не из чего
+	ErrOAuthEmailRequired = errors.New("identity provider returned no email, log in and link the account instead")
+	// Аккаунт с таким email уже есть, но провайдер не подтвердил, что почта принадлежит пользователю
+	ErrOAuthEmailConflict    = errors.New("account with this email already exists, log in and link the provider")
+	ErrIdentityAlreadyLinked = errors.New("identity is linked to another account")
+	ErrIdentityNotFound      = errors.New("identity not found")
+	ErrProviderAlreadyLinked = errors.New("another account of this provider is already linked")
+	// Нельзя отвязать последний способ входа у аккаунта без пароля
+	ErrLastLoginMethod = errors.New("cannot unlink the only login method, set a password first")
+)
+
+// ExternalIdentity — аккаунт у внешнего провайдера (Google, VK, Telegram), привязанный к пользователю
+type ExternalIdentity struct {
+	ID       uint      `gorm:"primaryKey"`
+	UserID   uuid.UUID `gorm:"type:uuid;uniqueIndex:idx_identity_user_provider;not null"`
+	Provider string    `gorm:"uniqueIndex:idx_identity_provider_subject;uniqueIndex:idx_identity_user_provider;not null"`
+	Subject  string    `gorm:"uniqueIndex:idx_identity_provider_subject;not null"`
+	// Email у провайдера на момент привязки — только для отображения
+	Email     string
+	CreatedAt time.Time
+}
+
+// OAuthState — то, что нужно помнить между редиректом к провайдеру и callback
+type OAuthState struct {
+	Provider     string
+	CodeVerifier string
+	Nonce        string
+	DeviceID     string
+	DeviceName   string
+	// Не пусто — привязка провайдера к уже вошедшему пользователю, а не вход
+	LinkUserID string
+}
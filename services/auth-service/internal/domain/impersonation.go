@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrImpersonationReason = errors.New("impersonation reason is required")
+	ErrPermissionDenied    = errors.New("permission denied")
+	// Иначе через чужую сессию можно получить права сотрудника
+	ErrImpersonatePrivileged = errors.New("cannot impersonate staff accounts")
+	ErrImpersonateSelf       = errors.New("cannot impersonate yourself")
+)
+
+// События журнала имперсонации
+const (
+	ImpersonationStarted = "start"
+	ImpersonationRequest = "request"
+)
+
+// ImpersonationAudit — запись журнала: выдача токена «войти как пользователь»
+// или запрос, сделанный с таким токеном
+type ImpersonationAudit struct {
+	ID      uint      `gorm:"primaryKey"`
+	ActorID uuid.UUID `gorm:"type:uuid;index;not null"`
+	UserID  uuid.UUID `gorm:"type:uuid;index;not null"`
+	// jti токена — связывает запросы с выдачей
+	TokenID   string `gorm:"index;not null"`
+	Event     string `gorm:"not null"`
+	Reason    string
+	Method    string
+	Path      string
+	IP        string
+	CreatedAt time.Time
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var ErrInvalidDeviceRevokeToken = errors.New("invalid or expired device revoke link")
+
+// Способ входа: password, telegram, magic_link или имя OAuth-провайдера (google, vk, ...)
+const (
+	LoginMethodPassword  = "password"
+	LoginMethodTelegram  = "telegram"
+	LoginMethodMagicLink = "magic_link"
+)
+
+// Причины неудачного входа в истории
+const (
+	LoginFailInvalidPassword  = "invalid_password"
+	LoginFailThrottled        = "throttled"
+	LoginFailInvalidTwoFactor = "invalid_two_factor_code"
+	LoginFailDeviceLimit      = "device_limit"
+	LoginFailBanned           = "banned"
+)
+
+// LoginEvent — запись в истории входов. Таблица только пополняется;
+// записи удаляются вместе с аккаунтом.
+type LoginEvent struct {
+	ID            uint      `gorm:"primaryKey"`
+	UserID        uuid.UUID `gorm:"type:uuid;index:idx_login_event_user_time;not null"`
+	Method        string    `gorm:"size:32"`
+	Success       bool
+	FailureReason string `gorm:"size:64"`
+	IP            string `gorm:"size:64"`
+	UserAgent     string `gorm:"size:512"`
+	DeviceID      string `gorm:"size:64;index"`
+	DeviceName    string
+	// Первый успешный вход с этого устройства — пользователю ушло письмо
+	NewDevice bool
+	CreatedAt time.Time `gorm:"index:idx_login_event_user_time"`
+}
+
+// LoginAttempt — как и откуда пользователь входит; IP и User-Agent передает Gateway
+type LoginAttempt struct {
+	Method     string
+	DeviceID   string
+	DeviceName string
+	IP         string
+	UserAgent  string
+}
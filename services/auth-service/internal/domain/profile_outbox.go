@@ -0,0 +1,21 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ProfileOutbox — профиль, который еще нужно создать в User Service.
+// Пишется в одной транзакции с пользователем и удаляется после успешного CreateProfile.
+type ProfileOutbox struct {
+	ID            uint      `gorm:"primaryKey"`
+	UserID        uuid.UUID `gorm:"type:uuid;uniqueIndex"`
+	Email         string
+	Username      string
+	EmailVerified bool
+	Attempts      int
+	NextAttemptAt time.Time `gorm:"index"`
+	LastError     string
+	CreatedAt     time.Time
+}
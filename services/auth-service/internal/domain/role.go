@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Роли. Пользователь без записей в user_roles — обычный RoleUser.
+const (
+	RoleAdmin          = "admin"
+	RoleContentManager = "content-manager"
+	RoleSupport        = "support"
+	RoleUser           = "user"
+)
+
+// Права, которые видят сервисы (claim "perms" в access-токене)
+const (
+	// Создавать и удалять курсы
+	PermCoursesWrite = "courses:write"
+	// Доступ ко всем курсам и аватаркам без учета подписки
+	PermContentAll = "content:all"
+	// Смотреть устройства и историю входов чужих аккаунтов
+	PermUsersRead = "users:read"
+	// Разлогинивать чужие аккаунты
+	PermSessionsRevoke = "sessions:revoke"
+	// Выдавать и отзывать роли
+	PermRolesManage = "roles:manage"
+	// Входить от имени пользователя (токен с claim "act")
+	PermImpersonate = "users:impersonate"
+	// Банить и разбанивать пользователей
+	PermUsersBan = "users:ban"
+	// Менять призы колеса фортуны
+	PermShopManage = "shop:manage"
+)
+
+var RolePermissions = map[string][]string{
+	RoleAdmin:          {PermCoursesWrite, PermContentAll, PermUsersRead, PermSessionsRevoke, PermRolesManage, PermImpersonate, PermUsersBan, PermShopManage},
+	RoleContentManager: {PermCoursesWrite, PermContentAll},
+	RoleSupport:        {PermUsersRead, PermSessionsRevoke, PermImpersonate, PermUsersBan},
+	RoleUser:           {},
+}
+
+var (
+	ErrUnknownRole = errors.New("unknown role")
+	// Иначе выдавать роли станет некому
+	ErrLastAdmin = errors.New("cannot revoke the last admin")
+)
+
+// UserRole — выданная пользователю роль. RoleUser не хранится: она есть у всех.
+type UserRole struct {
+	UserID uuid.UUID `gorm:"type:uuid;primaryKey"`
+	Role   string    `gorm:"primaryKey"`
+	// Кто выдал; nil — выдана при старте из ADMIN_EMAILS
+	GrantedBy *uuid.UUID `gorm:"type:uuid"`
+	CreatedAt time.Time
+}
+
+func ValidRole(role string) bool {
+	_, ok := RolePermissions[role]
+	return ok
+}
+
+// Permissions — объединение прав всех ролей, отсортированное
+func Permissions(roles []string) []string {
+	seen := make(map[string]bool)
+	perms := []string{}
+	for _, role := range roles {
+		for _, p := range RolePermissions[role] {
+			if !seen[p] {
+				seen[p] = true
+				perms = append(perms, p)
+			}
+		}
+	}
+	sort.Strings(perms)
+	return perms
+}
+
+// Privileged — есть ли роль сверх обычного пользователя (таким аккаунтам положена 2FA)
+func Privileged(roles []string) bool {
+	for _, role := range roles {
+		if role != RoleUser {
+			return true
+		}
+	}
+	return false
+}
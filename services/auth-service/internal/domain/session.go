@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrSessionRevoked = errors.New("session revoked")
+	ErrTokenReused    = errors.New("refresh token reuse detected")
+)
+
+// Session — семейство refresh-токенов, которое начинается при входе с устройства.
+// При каждой ротации меняется только JTI актуального токена семейства.
+type Session struct {
+	ID        string // ID семейства (claim "fam" в refresh-токене)
+	UserID    string
+	DeviceID  string
+	JTI       string // jti последнего выданного refresh-токена
+	MFA       bool   // Вход подтвержден вторым фактором — переходит в claim "amr"
+	Method    string // Способ входа (LoginMethod* или OAuth-провайдер) — тоже для "amr"
+	CreatedAt time.Time
+}
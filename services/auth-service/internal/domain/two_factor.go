@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInvalidTwoFactorCode    = errors.New("invalid two-factor code")
+	ErrTwoFactorNotEnabled     = errors.New("two-factor authentication is not enabled")
+	ErrTwoFactorAlreadyEnabled = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorSetupExpired   = errors.New("two-factor setup expired, start again")
+	ErrChallengeExpired        = errors.New("login challenge expired")
+	// Слишком много неверных кодов подряд — проверка второго фактора временно закрыта
+	ErrTooManyTwoFactorAttempts = errors.New("too many two-factor attempts, try again later")
+)
+
+// RecoveryCode — одноразовый код для входа без телефона. Храним только хэш.
+type RecoveryCode struct {
+	ID        uint      `gorm:"primaryKey"`
+	UserID    uuid.UUID `gorm:"index;type:uuid"`
+	CodeHash  string    `gorm:"size:64;not null"`
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// LoginChallenge — первый шаг входа пройден (пароль верный), ждем код 2FA
+type LoginChallenge struct {
+	UserID      string
+	DeviceID    string
+	DeviceName  string
+	DeviceLimit int
+	// Политика тарифа на случай, если устройств уже слишком много
+	DevicePolicy string
+	// Для истории входов
+	Method    string
+	IP        string
+	UserAgent string
+}
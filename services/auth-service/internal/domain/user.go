@@ -2,21 +2,81 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 var (
-	ErrUserNotFound      = errors.New("user not found")
-	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrUserNotFound         = errors.New("user not found")
+	ErrUserAlreadyExists    = errors.New("user already exists")
+	ErrEmailAlreadyVerified = errors.New("email already verified")
+	ErrInvalidVerifyToken   = errors.New("invalid or expired verification token")
+	// Одна ошибка на «нет такого email» и «неверный пароль»
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrInvalidUnlockToken = errors.New("invalid or expired unlock token")
+	ErrInvalidMagicLink   = errors.New("invalid or expired login link")
+	// Пароль не прошел политику; причина дописывается через %w
+	ErrWeakPassword      = errors.New("weak password")
+	ErrInvalidResetToken = errors.New("invalid or expired token")
+	// Удаление аккаунта
+	ErrInvalidDeletionToken = errors.New("invalid or expired account deletion token")
+	ErrDeletionScheduled    = errors.New("account deletion is already scheduled")
+	// Льготный период кончился: аккаунт стирается, войти в него уже нельзя
+	ErrAccountDeleted = errors.New("account has been deleted")
+	ErrDeletionNotDue = errors.New("account deletion is not due")
 )
 
 type User struct {
-	ID        uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
-	Email     string    `gorm:"uniqueIndex;not null"` // <--- Вот это создаст уникальность
-	Username  string    `gorm:"uniqueIndex;not null"` // Скорее всего, юзернейм тоже нужен уникальный?
-	Password  string    `gorm:"not null"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID       uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Email    string    `gorm:"uniqueIndex;not null"` // <--- Вот это создаст уникальность
+	Username string    `gorm:"uniqueIndex;not null"` // Скорее всего, юзернейм тоже нужен уникальный?
+	// Пустой у аккаунтов, созданных через внешнего провайдера, пока не задан пароль
+	Password string `gorm:"not null"`
+	// nil — почта не подтверждена, покупки и промокоды недоступны
+	EmailVerifiedAt *time.Time
+	// Секрет TOTP (base32). Пустой, пока 2FA не включена
+	TOTPSecret    string     `gorm:"column:totp_secret"`
+	TOTPEnabledAt *time.Time `gorm:"column:totp_enabled_at"`
+	// Не nil — пользователь удалил аккаунт; после этого времени данные сотрутся во всех сервисах.
+	// Вход до этого момента отменяет удаление.
+	DeleteAfter *time.Time `gorm:"index"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (u *User) TwoFactorEnabled() bool {
+	return u.TOTPEnabledAt != nil && u.TOTPSecret != ""
+}
+
+func (u *User) HasPassword() bool {
+	return u.Password != ""
+}
+
+func (u *User) EmailVerified() bool {
+	return u.EmailVerifiedAt != nil
+}
+
+func (u *User) DeletionScheduled() bool {
+	return u.DeleteAfter != nil
+}
+
+// DeletionDue — льготный период кончился, отменить удаление уже нельзя
+func (u *User) DeletionDue(now time.Time) bool {
+	return u.DeleteAfter != nil && !now.Before(*u.DeleteAfter)
+}
+
+// LoginThrottledError — слишком много неудачных попыток входа
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+	// Locked — аккаунт заблокирован, снять блокировку можно по ссылке из письма
+	Locked bool
+}
+
+func (e *LoginThrottledError) Error() string {
+	if e.Locked {
+		return fmt.Sprintf("account temporarily locked, try again in %d min or use the link from email", int(e.RetryAfter.Minutes())+1)
+	}
+	return fmt.Sprintf("too many failed attempts, try again in %d s", int(e.RetryAfter.Seconds())+1)
 }
@@ -3,35 +3,412 @@ package cache
 import (
 	"context"
 	"errors"
+	"strconv"
 	"strings"
 	"time"
 
+	"auth-service/internal/domain"
+
 	"github.com/redis/go-redis/v9"
 )
 
 type TokenCache struct {
 	client *redis.Client
+	// Семейства refresh-токенов живут столько же, сколько сам refresh-токен
+	sessionTTL time.Duration
+	// Дольше этого срока держать отзыв access-токенов бессмысленно — они уже истекли
+	accessTTL time.Duration
 }
 
-func NewTokenCache(client *redis.Client) *TokenCache {
-	return &TokenCache{client: client}
+func NewTokenCache(client *redis.Client, sessionTTL, accessTTL time.Duration) *TokenCache {
+	return &TokenCache{client: client, sessionTTL: sessionTTL, accessTTL: accessTTL}
 }
 
-func (c *TokenCache) SaveRefresh(ctx context.Context, userID string, refreshToken string) error {
-	// Храним 7 дней
-	return c.client.Set(ctx, "refresh_token:"+refreshToken, userID, 7*24*time.Hour).Err()
+// rotateScript атомарно меняет jti семейства, только если предъявлен актуальный токен.
+// Иначе возвращает 0 — это повторное использование уже ротированного токена.
+var rotateScript = redis.NewScript(`
+if redis.call("HGET", KEYS[1], "jti") == ARGV[1] then
+	redis.call("HSET", KEYS[1], "jti", ARGV[2])
+	redis.call("PEXPIRE", KEYS[1], ARGV[3])
+	return 1
+end
+return 0
+`)
+
+func sessionKey(id string) string {
+	return "refresh_family:" + id
 }
 
-func (c *TokenCache) CheckRefresh(ctx context.Context, refreshToken string) (string, error) {
-	val, err := c.client.Get(ctx, "refresh_token:"+refreshToken).Result()
+func deviceSessionsKey(userID, deviceID string) string {
+	return "device_families:" + userID + ":" + deviceID
+}
+
+func userSessionsKey(userID string) string {
+	return "user_families:" + userID
+}
+
+func (c *TokenCache) SaveSession(ctx context.Context, s *domain.Session) error {
+	pipe := c.client.TxPipeline()
+	pipe.HSet(ctx, sessionKey(s.ID), map[string]interface{}{
+		"user_id":    s.UserID,
+		"device_id":  s.DeviceID,
+		"jti":        s.JTI,
+		"mfa":        s.MFA,
+		"method":     s.Method,
+		"created_at": s.CreatedAt.Unix(),
+	})
+	pipe.Expire(ctx, sessionKey(s.ID), c.sessionTTL)
+	pipe.SAdd(ctx, deviceSessionsKey(s.UserID, s.DeviceID), s.ID)
+	pipe.Expire(ctx, deviceSessionsKey(s.UserID, s.DeviceID), c.sessionTTL)
+	pipe.SAdd(ctx, userSessionsKey(s.UserID), s.ID)
+	pipe.Expire(ctx, userSessionsKey(s.UserID), c.sessionTTL)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *TokenCache) GetSession(ctx context.Context, id string) (*domain.Session, error) {
+	vals, err := c.client.HGetAll(ctx, sessionKey(id)).Result()
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return val, nil
+	if len(vals) == 0 {
+		return nil, domain.ErrSessionRevoked
+	}
+	createdAt, _ := strconv.ParseInt(vals["created_at"], 10, 64)
+	return &domain.Session{
+		ID:        id,
+		UserID:    vals["user_id"],
+		DeviceID:  vals["device_id"],
+		JTI:       vals["jti"],
+		MFA:       vals["mfa"] == "1",
+		Method:    vals["method"],
+		CreatedAt: time.Unix(createdAt, 0),
+	}, nil
+}
+
+// RotateSession возвращает false, если oldJTI уже не актуален
+func (c *TokenCache) RotateSession(ctx context.Context, s *domain.Session, oldJTI, newJTI string) (bool, error) {
+	ok, err := rotateScript.Run(ctx, c.client, []string{sessionKey(s.ID)}, oldJTI, newJTI, c.sessionTTL.Milliseconds()).Int()
+	if err != nil {
+		return false, err
+	}
+	if ok == 1 {
+		c.client.Expire(ctx, deviceSessionsKey(s.UserID, s.DeviceID), c.sessionTTL)
+		c.client.Expire(ctx, userSessionsKey(s.UserID), c.sessionTTL)
+	}
+	return ok == 1, nil
+}
+
+func (c *TokenCache) DeleteSession(ctx context.Context, s *domain.Session) error {
+	pipe := c.client.TxPipeline()
+	pipe.Del(ctx, sessionKey(s.ID))
+	pipe.SRem(ctx, deviceSessionsKey(s.UserID, s.DeviceID), s.ID)
+	pipe.SRem(ctx, userSessionsKey(s.UserID), s.ID)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// DeleteDeviceSessions отзывает все семейства токенов пользователя на устройстве
+func (c *TokenCache) DeleteDeviceSessions(ctx context.Context, userID, deviceID string) error {
+	key := deviceSessionsKey(userID, deviceID)
+	ids, err := c.client.SMembers(ctx, key).Result()
+	if err != nil {
+		return err
+	}
+	return c.deleteSessions(ctx, userID, ids, key)
+}
+
+// DeleteUserSessions отзывает все семейства токенов пользователя.
+// Сессии устройства exceptDeviceID (если задано) остаются.
+func (c *TokenCache) DeleteUserSessions(ctx context.Context, userID, exceptDeviceID string) error {
+	ids, err := c.client.SMembers(ctx, userSessionsKey(userID)).Result()
+	if err != nil {
+		return err
+	}
+
+	var revoke []string
+	for _, id := range ids {
+		if exceptDeviceID != "" {
+			deviceID, err := c.client.HGet(ctx, sessionKey(id), "device_id").Result()
+			if err == nil && deviceID == exceptDeviceID {
+				continue
+			}
+		}
+		revoke = append(revoke, id)
+	}
+	return c.deleteSessions(ctx, userID, revoke)
+}
+
+func (c *TokenCache) deleteSessions(ctx context.Context, userID string, ids []string, extraKeys ...string) error {
+	if len(ids) == 0 && len(extraKeys) == 0 {
+		return nil
+	}
+
+	keys := extraKeys
+	members := make([]interface{}, 0, len(ids))
+	for _, id := range ids {
+		keys = append(keys, sessionKey(id))
+		members = append(members, id)
+	}
+
+	pipe := c.client.TxPipeline()
+	pipe.Del(ctx, keys...)
+	if len(members) > 0 {
+		pipe.SRem(ctx, userSessionsKey(userID), members...)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// RevokeAccessToken заносит jti в denylist до момента, когда токен истечет сам
+func (c *TokenCache) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		return nil
+	}
+	return c.client.Set(ctx, "revoked_jti:"+jti, 1, ttl).Err()
+}
+
+// RevokeTokensIssuedBefore отзывает все access-токены пользователя, выпущенные до момента t.
+// Момент хранится в миллисекундах, как и "iat" токена
+func (c *TokenCache) RevokeTokensIssuedBefore(ctx context.Context, userID string, t time.Time) error {
+	return c.client.Set(ctx, "revoked_before:"+userID, t.UnixMilli(), c.accessTTL).Err()
+}
+
+// IsAccessRevoked проверяет токен по обоим denylist'ам
+func (c *TokenCache) IsAccessRevoked(ctx context.Context, userID, jti string, issuedAt time.Time) (bool, error) {
+	vals, err := c.client.MGet(ctx, "revoked_jti:"+jti, "revoked_before:"+userID).Result()
+	if err != nil {
+		return false, err
+	}
+	if vals[0] != nil {
+		return true, nil
+	}
+	if before, ok := vals[1].(string); ok {
+		ts, _ := strconv.ParseInt(before, 10, 64)
+		return issuedAt.UnixMilli() < ts, nil
+	}
+	return false, nil
+}
+
+// SaveTOTPSetup хранит секрет, пока пользователь не подтвердит его первым кодом
+func (c *TokenCache) SaveTOTPSetup(ctx context.Context, userID, secret string) error {
+	return c.client.Set(ctx, "totp_setup:"+userID, secret, 10*time.Minute).Err()
+}
+
+func (c *TokenCache) GetTOTPSetup(ctx context.Context, userID string) (string, error) {
+	return c.client.Get(ctx, "totp_setup:"+userID).Result()
+}
+
+func (c *TokenCache) DeleteTOTPSetup(ctx context.Context, userID string) {
+	c.client.Del(ctx, "totp_setup:"+userID)
+}
+
+// MarkTOTPStepUsed не дает принять один и тот же код дважды (RFC 6238, 5.2).
+// false — код этого шага уже использовали.
+func (c *TokenCache) MarkTOTPStepUsed(ctx context.Context, userID string, step int64) (bool, error) {
+	key := "totp_used:" + userID + ":" + strconv.FormatInt(step, 10)
+	// Шаг можно принять в пределах окна ±30с, после этого ключ не нужен
+	return c.client.SetNX(ctx, key, 1, 2*time.Minute).Result()
+}
+
+func challengeKey(token string) string {
+	return "login_challenge:" + token
+}
+
+// SaveLoginChallenge запоминает, что пароль проверен, и ждет второй фактор
+func (c *TokenCache) SaveLoginChallenge(ctx context.Context, token string, ch *domain.LoginChallenge) error {
+	pipe := c.client.TxPipeline()
+	pipe.HSet(ctx, challengeKey(token), map[string]interface{}{
+		"user_id":       ch.UserID,
+		"device_id":     ch.DeviceID,
+		"device_name":   ch.DeviceName,
+		"device_limit":  ch.DeviceLimit,
+		"device_policy": ch.DevicePolicy,
+		"method":        ch.Method,
+		"ip":            ch.IP,
+		"user_agent":    ch.UserAgent,
+		"attempts":      0,
+	})
+	pipe.Expire(ctx, challengeKey(token), 5*time.Minute)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// GetLoginChallenge засчитывает попытку ввода кода. После maxAttempts челлендж сгорает.
+func (c *TokenCache) GetLoginChallenge(ctx context.Context, token string, maxAttempts int) (*domain.LoginChallenge, error) {
+	attempts, err := c.client.HIncrBy(ctx, challengeKey(token), "attempts", 1).Result()
+	if err != nil {
+		return nil, err
+	}
+	vals, err := c.client.HGetAll(ctx, challengeKey(token)).Result()
+	if err != nil {
+		return nil, err
+	}
+	// HINCRBY создает ключ без TTL, если челленджа не было
+	if vals["user_id"] == "" || attempts > int64(maxAttempts) {
+		c.client.Del(ctx, challengeKey(token))
+		return nil, domain.ErrChallengeExpired
+	}
+
+	limit, _ := strconv.Atoi(vals["device_limit"])
+	return &domain.LoginChallenge{
+		UserID:       vals["user_id"],
+		DeviceID:     vals["device_id"],
+		DeviceName:   vals["device_name"],
+		DeviceLimit:  limit,
+		DevicePolicy: vals["device_policy"],
+		Method:       vals["method"],
+		IP:           vals["ip"],
+		UserAgent:    vals["user_agent"],
+	}, nil
+}
+
+func (c *TokenCache) DeleteLoginChallenge(ctx context.Context, token string) {
+	c.client.Del(ctx, challengeKey(token))
+}
+
+func loginFailAccountKey(email string) string { return "login_fail:acct:" + email }
+func loginFailIPKey(ip string) string         { return "login_fail:ip:" + ip }
+func loginDelayKey(email string) string       { return "login_delay:" + email }
+func loginLockKey(email string) string        { return "login_lock:" + email }
+
+// LoginBlockedFor возвращает, сколько еще ждать до следующей попытки входа в аккаунт
+// (0 — можно пробовать), и заблокирован ли он целиком до разблокировки по письму.
+func (c *TokenCache) LoginBlockedFor(ctx context.Context, email string) (time.Duration, bool, error) {
+	pipe := c.client.Pipeline()
+	lock := pipe.PTTL(ctx, loginLockKey(email))
+	delay := pipe.PTTL(ctx, loginDelayKey(email))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, false, err
+	}
+	// PTTL возвращает отрицательное значение, если ключа нет
+	if lock.Val() > 0 {
+		return lock.Val(), true, nil
+	}
+	if delay.Val() > 0 {
+		return delay.Val(), false, nil
+	}
+	return 0, false, nil
+}
+
+// LoginFailuresFromIP — число неудачных входов с адреса за текущее окно
+func (c *TokenCache) LoginFailuresFromIP(ctx context.Context, ip string) (int64, error) {
+	n, err := c.client.Get(ctx, loginFailIPKey(ip)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return n, err
+}
+
+// RecordLoginFailure увеличивает оба счетчика и возвращает счетчик аккаунта.
+// Окно считается от первой ошибки: ключи не продлеваются на каждой попытке.
+func (c *TokenCache) RecordLoginFailure(ctx context.Context, email, ip string, accountWindow, ipWindow time.Duration) (int64, error) {
+	pipe := c.client.TxPipeline()
+	acct := pipe.Incr(ctx, loginFailAccountKey(email))
+	pipe.ExpireNX(ctx, loginFailAccountKey(email), accountWindow)
+	if ip != "" {
+		pipe.Incr(ctx, loginFailIPKey(ip))
+		pipe.ExpireNX(ctx, loginFailIPKey(ip), ipWindow)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return acct.Val(), nil
+}
+
+// DelayLogin запрещает попытки входа в аккаунт на время d
+func (c *TokenCache) DelayLogin(ctx context.Context, email string, d time.Duration) error {
+	return c.client.Set(ctx, loginDelayKey(email), 1, d).Err()
+}
+
+func (c *TokenCache) LockLogin(ctx context.Context, email string, d time.Duration) error {
+	return c.client.Set(ctx, loginLockKey(email), 1, d).Err()
+}
+
+// ClearLoginFailures снимает задержку, блокировку и счетчик аккаунта.
+// Счетчик IP не трогаем: успешный вход в свой аккаунт не должен обнулять перебор чужих.
+func (c *TokenCache) ClearLoginFailures(ctx context.Context, email string) error {
+	return c.client.Del(ctx, loginFailAccountKey(email), loginDelayKey(email), loginLockKey(email)).Err()
+}
+
+func twoFactorFailKey(userID string) string { return "2fa_fail:" + userID }
+
+// TwoFactorFailures — число неверных кодов второго фактора за текущее окно
+func (c *TokenCache) TwoFactorFailures(ctx context.Context, userID string) (int64, error) {
+	n, err := c.client.Get(ctx, twoFactorFailKey(userID)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return n, err
+}
+
+// RecordTwoFactorFailure увеличивает счетчик; окно считается от первой ошибки
+func (c *TokenCache) RecordTwoFactorFailure(ctx context.Context, userID string, window time.Duration) error {
+	pipe := c.client.TxPipeline()
+	pipe.Incr(ctx, twoFactorFailKey(userID))
+	pipe.ExpireNX(ctx, twoFactorFailKey(userID), window)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+func (c *TokenCache) ClearTwoFactorFailures(ctx context.Context, userID string) error {
+	return c.client.Del(ctx, twoFactorFailKey(userID)).Err()
+}
+
+func (c *TokenCache) SaveUnlockToken(ctx context.Context, token, email string, ttl time.Duration) error {
+	return c.client.Set(ctx, "unlock_token:"+token, email, ttl).Err()
+}
+
+func (c *TokenCache) GetUnlockToken(ctx context.Context, token string) (string, error) {
+	return c.client.Get(ctx, "unlock_token:"+token).Result()
+}
+
+func (c *TokenCache) DeleteUnlockToken(ctx context.Context, token string) {
+	c.client.Del(ctx, "unlock_token:"+token)
+}
+
+func oauthStateKey(state string) string {
+	return "oauth_state:" + state
 }
 
-func (c *TokenCache) DeleteRefresh(ctx context.Context, refreshToken string) error {
-	return c.client.Del(ctx, "refresh_token:"+refreshToken).Err()
+// SaveOAuthState хранит PKCE verifier и данные устройства, пока пользователь у провайдера
+func (c *TokenCache) SaveOAuthState(ctx context.Context, state string, s *domain.OAuthState) error {
+	pipe := c.client.TxPipeline()
+	pipe.HSet(ctx, oauthStateKey(state), map[string]interface{}{
+		"provider":      s.Provider,
+		"code_verifier": s.CodeVerifier,
+		"nonce":         s.Nonce,
+		"device_id":     s.DeviceID,
+		"device_name":   s.DeviceName,
+		"link_user_id":  s.LinkUserID,
+	})
+	pipe.Expire(ctx, oauthStateKey(state), 10*time.Minute)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// TakeOAuthState читает и сразу удаляет state: один state — один callback
+func (c *TokenCache) TakeOAuthState(ctx context.Context, state string) (*domain.OAuthState, error) {
+	pipe := c.client.TxPipeline()
+	get := pipe.HGetAll(ctx, oauthStateKey(state))
+	pipe.Del(ctx, oauthStateKey(state))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	vals := get.Val()
+	if vals["provider"] == "" {
+		return nil, domain.ErrInvalidOAuthState
+	}
+	return &domain.OAuthState{
+		Provider:     vals["provider"],
+		CodeVerifier: vals["code_verifier"],
+		Nonce:        vals["nonce"],
+		DeviceID:     vals["device_id"],
+		DeviceName:   vals["device_name"],
+		LinkUserID:   vals["link_user_id"],
+	}, nil
 }
 
 func (c *TokenCache) SaveResetToken(ctx context.Context, token string, userID string) error {
@@ -50,6 +427,29 @@ func (c *TokenCache) DeleteResetToken(ctx context.Context, token string) error {
 	return c.client.Del(ctx, "reset_token:"+token).Err()
 }
 
+// Ссылка для входа без пароля живет как ссылка на сброс пароля
+func (c *TokenCache) SaveMagicLinkToken(ctx context.Context, token, userID string) error {
+	return c.client.Set(ctx, "magic_link:"+token, userID, 15*time.Minute).Err()
+}
+
+// TakeMagicLinkToken забирает токен атомарно: по одной ссылке входят один раз
+func (c *TokenCache) TakeMagicLinkToken(ctx context.Context, token string) (string, error) {
+	return c.client.GetDel(ctx, "magic_link:"+token).Result()
+}
+
+// Ссылка из письма после регистрации живет сутки
+func (c *TokenCache) SaveEmailVerifyToken(ctx context.Context, token, userID string) error {
+	return c.client.Set(ctx, "email_verify:"+token, userID, 24*time.Hour).Err()
+}
+
+func (c *TokenCache) GetEmailVerifyToken(ctx context.Context, token string) (string, error) {
+	return c.client.Get(ctx, "email_verify:"+token).Result()
+}
+
+func (c *TokenCache) DeleteEmailVerifyToken(ctx context.Context, token string) {
+	c.client.Del(ctx, "email_verify:"+token)
+}
+
 func (c *TokenCache) SaveEmailChangeToken(ctx context.Context, token, userID, newEmail string) error {
 	val := userID + "|" + newEmail
 	return c.client.Set(ctx, "email_change:"+token, val, 15*time.Minute).Err()
@@ -70,3 +470,85 @@ func (c *TokenCache) GetEmailChangeData(ctx context.Context, token string) (stri
 func (c *TokenCache) DeleteEmailChangeToken(ctx context.Context, token string) {
 	c.client.Del(ctx, "email_change:"+token)
 }
+
+// Ссылка на подтверждение удаления аккаунта (для аккаунтов без пароля) живет час
+func (c *TokenCache) SaveAccountDeletionToken(ctx context.Context, token, userID string) error {
+	return c.client.Set(ctx, "account_deletion:"+token, userID, time.Hour).Err()
+}
+
+// TakeAccountDeletionToken забирает токен атомарно: одна ссылка — одно подтверждение
+func (c *TokenCache) TakeAccountDeletionToken(ctx context.Context, token string) (string, error) {
+	return c.client.GetDel(ctx, "account_deletion:"+token).Result()
+}
+
+func deviceRevokeKey(token string) string {
+	return "device_revoke:" + token
+}
+
+// Ссылка «это был не я» из письма о новом устройстве живет, пока может жить сессия этого входа
+func (c *TokenCache) SaveDeviceRevokeToken(ctx context.Context, token, userID, deviceID string) error {
+	return c.client.Set(ctx, deviceRevokeKey(token), userID+"|"+deviceID, c.sessionTTL).Err()
+}
+
+func (c *TokenCache) TakeDeviceRevokeToken(ctx context.Context, token string) (string, string, error) {
+	val, err := c.client.GetDel(ctx, deviceRevokeKey(token)).Result()
+	if err != nil {
+		return "", "", err
+	}
+	userID, deviceID, ok := strings.Cut(val, "|")
+	if !ok {
+		return "", "", errors.New("invalid token data")
+	}
+	return userID, deviceID, nil
+}
+
+func deviceChallengeKey(token string) string {
+	return "device_challenge:" + token
+}
+
+// SaveDeviceChallenge запоминает вход, который ждет выбора устройства на замену
+func (c *TokenCache) SaveDeviceChallenge(ctx context.Context, token string, ch *domain.DeviceChallenge) error {
+	pipe := c.client.TxPipeline()
+	pipe.HSet(ctx, deviceChallengeKey(token), map[string]interface{}{
+		"user_id":      ch.UserID,
+		"device_limit": ch.DeviceLimit,
+		"mfa":          strconv.FormatBool(ch.MFA),
+		"method":       ch.Attempt.Method,
+		"device_id":    ch.Attempt.DeviceID,
+		"device_name":  ch.Attempt.DeviceName,
+		"ip":           ch.Attempt.IP,
+		"user_agent":   ch.Attempt.UserAgent,
+	})
+	pipe.Expire(ctx, deviceChallengeKey(token), 5*time.Minute)
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// TakeDeviceChallenge читает и удаляет челлендж: выбрать устройство можно один раз
+func (c *TokenCache) TakeDeviceChallenge(ctx context.Context, token string) (*domain.DeviceChallenge, error) {
+	pipe := c.client.TxPipeline()
+	get := pipe.HGetAll(ctx, deviceChallengeKey(token))
+	pipe.Del(ctx, deviceChallengeKey(token))
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	vals := get.Val()
+	if vals["user_id"] == "" {
+		return nil, domain.ErrDeviceChallengeExpired
+	}
+	limit, _ := strconv.Atoi(vals["device_limit"])
+	mfa, _ := strconv.ParseBool(vals["mfa"])
+	return &domain.DeviceChallenge{
+		UserID:      vals["user_id"],
+		DeviceLimit: limit,
+		MFA:         mfa,
+		Attempt: domain.LoginAttempt{
+			Method:     vals["method"],
+			DeviceID:   vals["device_id"],
+			DeviceName: vals["device_name"],
+			IP:         vals["ip"],
+			UserAgent:  vals["user_agent"],
+		},
+	}, nil
+}
@@ -0,0 +1,110 @@
+package email
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"auth-service/internal/infrastructure/repository"
+)
+
+const (
+	dispatchBatch = 20
+	sendTimeout   = 30 * time.Second
+	// Пока пачка отправляется, другие реплики ее не берут. Письма уходят по одному,
+	// поэтому аренда длиннее худшего случая — все письма пачки до таймаута
+	dispatchLease = dispatchBatch*sendTimeout + time.Minute
+	// Повторы через 30с, 1м, 2м ... не реже раза в час; после maxAttempts письмо уходит в dead
+	retryBaseDelay = 30 * time.Second
+	retryMaxDelay  = time.Hour
+	maxAttempts    = 8
+	// Сколько хранить отправленные письма и dead-письма (от создания) для разбора
+	sentRetention = 7 * 24 * time.Hour
+	deadRetention = 7 * 24 * time.Hour
+)
+
+// Dispatcher отправляет письма из outbox через Mailer
+type Dispatcher struct {
+	outbox *repository.EmailOutboxRepository
+	mailer Mailer
+}
+
+func NewDispatcher(outbox *repository.EmailOutboxRepository, mailer Mailer) *Dispatcher {
+	return &Dispatcher{outbox: outbox, mailer: mailer}
+}
+
+// Run блокируется до отмены ctx
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	purge := time.NewTicker(time.Hour)
+	defer purge.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.dispatch(ctx)
+		case <-purge.C:
+			d.purge(ctx)
+		}
+	}
+}
+
+func (d *Dispatcher) purge(ctx context.Context) {
+	if n, err := d.outbox.PurgeSent(ctx, time.Now().Add(-sentRetention)); err != nil {
+		log.Printf("Email outbox: failed to purge: %v", err)
+	} else if n > 0 {
+		log.Printf("Email outbox: purged %d sent emails", n)
+	}
+	if n, err := d.outbox.PurgeDead(ctx, time.Now().Add(-deadRetention)); err != nil {
+		log.Printf("Email outbox: failed to purge dead: %v", err)
+	} else if n > 0 {
+		log.Printf("Email outbox: purged %d dead emails", n)
+	}
+}
+
+func (d *Dispatcher) dispatch(ctx context.Context) {
+	items, err := d.outbox.Claim(ctx, dispatchBatch, dispatchLease)
+	if err != nil {
+		log.Printf("Email outbox: failed to load: %v", err)
+		return
+	}
+
+	for _, item := range items {
+		attempts := item.Attempts + 1
+		sendCtx, cancel := context.WithTimeout(ctx, sendTimeout)
+		err := d.mailer.Send(sendCtx, Message{To: item.To, Subject: item.Subject, HTML: item.HTML, Text: item.Text})
+		cancel()
+
+		if err == nil {
+			if err := d.outbox.MarkSent(ctx, item.ID, attempts); err != nil {
+				log.Printf("Email outbox: failed to mark %d sent: %v", item.ID, err)
+			}
+			continue
+		}
+
+		if attempts >= maxAttempts {
+			log.Printf("ERROR: Email outbox: %s email %d to %s is dead after %d attempts: %v", item.Kind, item.ID, item.To, attempts, err)
+			if err := d.outbox.MarkDead(ctx, item.ID, attempts, err.Error()); err != nil {
+				log.Printf("Email outbox: failed to mark %d dead: %v", item.ID, err)
+			}
+			continue
+		}
+
+		log.Printf("Email outbox: attempt %d for %s email %d failed: %v", attempts, item.Kind, item.ID, err)
+		next := time.Now().Add(retryDelay(attempts))
+		if err := d.outbox.Reschedule(ctx, item.ID, attempts, next, err.Error()); err != nil {
+			log.Printf("Email outbox: failed to reschedule %d: %v", item.ID, err)
+		}
+	}
+}
+
+func retryDelay(attempts int) time.Duration {
+	d := retryBaseDelay << (attempts - 1)
+	if d <= 0 || d > retryMaxDelay {
+		return retryMaxDelay
+	}
+	return d
+}
@@ -0,0 +1,46 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// FileMailer ничего не отправляет: сохраняет письма в каталог как .eml, чтобы открыть их
+// почтовым клиентом. С пустым каталогом только пишет тему и адрес в лог.
+type FileMailer struct {
+	dir  string
+	from mail.Address
+}
+
+func NewFileMailer(dir, from, fromName string) *FileMailer {
+	return &FileMailer{dir: dir, from: mail.Address{Name: fromName, Address: from}}
+}
+
+func (m *FileMailer) Send(_ context.Context, msg Message) error {
+	if m.dir == "" {
+		log.Printf("MAIL to=%s subject=%q (%d bytes)", msg.To, msg.Subject, len(msg.HTML))
+		return nil
+	}
+
+	raw, err := buildMIME(m.from, msg)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(m.dir, 0o755); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s_%s.eml", time.Now().Format("20060102-150405.000"), strings.NewReplacer("@", "_at_", "/", "_").Replace(msg.To))
+	path := filepath.Join(m.dir, name)
+	if err := os.WriteFile(path, raw, 0o600); err != nil {
+		return err
+	}
+	log.Printf("MAIL to=%s subject=%q saved to %s", msg.To, msg.Subject, path)
+	return nil
+}
@@ -0,0 +1,16 @@
+package email
+
+import "context"
+
+// Message — готовое к отправке письмо
+type Message struct {
+	To      string
+	Subject string
+	HTML    string
+	Text    string // Текстовая версия для клиентов без HTML; может быть пустой
+}
+
+// Mailer — способ доставки писем: SendGrid, SMTP или файлы для локальной разработки
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
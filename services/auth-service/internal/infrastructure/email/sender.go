@@ -1,253 +1,86 @@
 package email
 
 import (
-	"bytes"
-	"encoding/json"
-	"fmt"
-	"io"
-	"net/http"
+	"context"
+	"net/url"
 	"time"
+
+	"auth-service/internal/domain"
+	"auth-service/internal/infrastructure/repository"
 )
 
+// EmailSender собирает письма по шаблонам и ставит их в outbox; отправляет их Dispatcher
 type EmailSender struct {
-	apiKey      string
-	senderEmail string
-	senderName  string
-	frontend    string
+	outbox    *repository.EmailOutboxRepository
+	templates *Templates
+	frontend  string
 }
 
-func NewEmailSender(apiKey, senderEmail, frontend string) *EmailSender {
+func NewEmailSender(outbox *repository.EmailOutboxRepository, templates *Templates, frontend string) *EmailSender {
 	return &EmailSender{
-		apiKey:      apiKey,
-		senderEmail: senderEmail,
-		senderName:  "BazaKursov Support",
-		frontend:    frontend,
+		outbox:    outbox,
+		templates: templates,
+		frontend:  frontend,
 	}
 }
 
-// Структуры для API SendGrid
-type sgEmail struct {
-	Email string `json:"email"`
-	Name  string `json:"name,omitempty"`
-}
-type sgContent struct {
-	Type  string `json:"type"`
-	Value string `json:"value"`
-}
-type sgRequest struct {
-	Personalizations []struct {
-		To []sgEmail `json:"to"`
-	} `json:"personalizations"`
-	From    sgEmail     `json:"from"`
-	Subject string      `json:"subject"`
-	Content []sgContent `json:"content"`
-}
-
-// ---------------------------------------------------------
-// Публичные методы (формирование писем)
-// ---------------------------------------------------------
-
 // SendResetEmail отправляет ссылку на сброс пароля
-func (s *EmailSender) SendResetEmail(toEmail string, token string) error {
-	link := fmt.Sprintf("%s/reset-password?token=%s", s.frontend, token)
-	subject := "Восстановление пароля"
-
-	// Используем общий шаблон, меняем только заголовок, текст и текст кнопки
-	htmlBody := s.getHTMLTemplate(
-		"Восстановление доступа",
-		"Мы получили запрос на сброс пароля для вашего аккаунта.<br>Если это были вы, нажмите на кнопку ниже:",
-		"Установить новый пароль",
-		link,
-	)
-
-	return s.send(toEmail, subject, htmlBody)
+func (s *EmailSender) SendResetEmail(ctx context.Context, toEmail, locale, token string) error {
+	return s.enqueue(ctx, KindResetPassword, toEmail, locale, Params{Link: s.link("/reset-password", token)})
 }
 
-// SendEmailChangeConfirmation отправляет ссылку на подтверждение смены почты
-func (s *EmailSender) SendEmailChangeConfirmation(toEmail string, token string) error {
-	// Ссылка ведет на фронтенд, который дернет API
-	link := fmt.Sprintf("%s/confirm-email-change?token=%s", s.frontend, token)
-	subject := "Подтверждение смены Email"
+// SendMagicLinkEmail отправляет одноразовую ссылку для входа без пароля
+func (s *EmailSender) SendMagicLinkEmail(ctx context.Context, toEmail, locale, token string) error {
+	return s.enqueue(ctx, KindMagicLink, toEmail, locale, Params{Link: s.link("/magic-login", token)})
+}
 
-	htmlBody := s.getHTMLTemplate(
-		"Смена Email адреса",
-		"Вы запросили смену email адреса на этот почтовый ящик.<br>Для подтверждения нажмите кнопку ниже:",
-		"Подтвердить Email",
-		link,
-	)
+// SendVerificationEmail отправляет ссылку на подтверждение почты после регистрации
+func (s *EmailSender) SendVerificationEmail(ctx context.Context, toEmail, locale, token string) error {
+	return s.enqueue(ctx, KindVerifyEmail, toEmail, locale, Params{Link: s.link("/verify-email", token)})
+}
 
-	return s.send(toEmail, subject, htmlBody)
+// SendUnlockEmail сообщает о блокировке входа после серии неудачных попыток
+func (s *EmailSender) SendUnlockEmail(ctx context.Context, toEmail, locale, token string) error {
+	return s.enqueue(ctx, KindUnlockAccount, toEmail, locale, Params{Link: s.link("/unlock-account", token)})
 }
 
-// ---------------------------------------------------------
-// Приватные методы (внутренняя кухня)
-// ---------------------------------------------------------
+// SendEmailChangeConfirmation отправляет ссылку на подтверждение смены почты.
+// Ссылка ведет на фронтенд, который дернет API.
+func (s *EmailSender) SendEmailChangeConfirmation(ctx context.Context, toEmail, locale, token string) error {
+	return s.enqueue(ctx, KindEmailChange, toEmail, locale, Params{Link: s.link("/confirm-email-change", token)})
+}
 
-// send выполняет непосредственную отправку через SendGrid API
-func (s *EmailSender) send(toEmail, subject, htmlBody string) error {
-	body := sgRequest{
-		Personalizations: []struct {
-			To []sgEmail `json:"to"`
-		}{
-			{To: []sgEmail{{Email: toEmail}}},
-		},
-		From: sgEmail{
-			Email: s.senderEmail,
-			Name:  s.senderName,
-		},
-		Subject: subject,
-		Content: []sgContent{
-			{
-				Type:  "text/html",
-				Value: htmlBody,
-			},
-		},
-	}
+// SendAccountDeletionConfirmation отправляет ссылку на подтверждение удаления аккаунта без пароля
+func (s *EmailSender) SendAccountDeletionConfirmation(ctx context.Context, toEmail, locale, token string) error {
+	return s.enqueue(ctx, KindDeleteAccount, toEmail, locale, Params{Link: s.link("/confirm-account-deletion", token)})
+}
 
-	bodyBytes, err := json.Marshal(body)
-	if err != nil {
-		return err
-	}
+// SendAccountDeletionScheduled сообщает, когда аккаунт будет удален и что отменить удаление можно входом
+func (s *EmailSender) SendAccountDeletionScheduled(ctx context.Context, toEmail, locale string, deleteAfter time.Time) error {
+	return s.enqueue(ctx, KindAccountDeletionScheduled, toEmail, locale, Params{Link: s.frontend + "/login", Until: deleteAfter})
+}
 
-	req, err := http.NewRequest(
-		"POST",
-		"https://api.sendgrid.com/v3/mail/send",
-		bytes.NewBuffer(bodyBytes),
-	)
-	if err != nil {
-		return err
-	}
+// SendNewDeviceAlert сообщает о входе с нового устройства; ссылка «это был не я» завершает его сессию
+func (s *EmailSender) SendNewDeviceAlert(ctx context.Context, toEmail, locale, token string, login LoginDetails) error {
+	return s.enqueue(ctx, KindNewDevice, toEmail, locale, Params{Link: s.link("/revoke-device", token), Login: login})
+}
 
-	req.Header.Set("Authorization", "Bearer "+s.apiKey)
-	req.Header.Set("Content-Type", "application/json")
+func (s *EmailSender) link(path, token string) string {
+	return s.frontend + path + "?token=" + url.QueryEscape(token)
+}
 
-	client := &http.Client{Timeout: 15 * time.Second}
-	resp, err := client.Do(req)
+// enqueue сохраняет письмо в outbox — отправка переживет рестарт и сбой провайдера
+func (s *EmailSender) enqueue(ctx context.Context, kind, toEmail, locale string, p Params) error {
+	msg, err := s.templates.Render(kind, locale, p)
 	if err != nil {
 		return err
 	}
-	defer resp.Body.Close()
-
-	// SendGrid возвращает 200, 201 или 202 при успехе
-	if resp.StatusCode >= 400 {
-		respBody, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("sendgrid error: status=%d body=%s", resp.StatusCode, respBody)
-	}
-
-	return nil
-}
-
-// getHTMLTemplate возвращает HTML строку с подставленными значениями
-func (s *EmailSender) getHTMLTemplate(header, message, buttonText, link string) string {
-	return fmt.Sprintf(`
-	<!DOCTYPE html>
-	<html>
-	<head>
-		<meta charset="utf-8">
-		<meta name="viewport" content="width=device-width, initial-scale=1.0">
-		<title>%s</title>
-		<style>
-			body {
-				font-family: 'Helvetica Neue', Helvetica, Arial, sans-serif;
-				background-color: #0f0f11;
-				margin: 0;
-				padding: 0;
-				color: #ffffff;
-			}
-			.wrapper {
-				width: 100%%;
-				table-layout: fixed;
-				background-color: #0f0f11;
-				padding-bottom: 40px;
-			}
-			.container {
-				max-width: 480px;
-				margin: 40px auto;
-				background-color: #18181b;
-				padding: 40px;
-				border-radius: 16px;
-				border: 1px solid #27272a;
-				box-shadow: 0 4px 30px rgba(0, 0, 0, 0.5);
-				text-align: center;
-			}
-			.logo {
-				margin-bottom: 30px;
-				font-size: 24px;
-				font-weight: 800;
-				letter-spacing: -0.5px;
-				text-decoration: none;
-			}
-			.logo-white { color: #ffffff; }
-			.logo-teal { color:rgb(212, 45, 45); }
-			
-			h3 {
-				color: #ffffff;
-				margin-top: 0;
-				margin-bottom: 16px;
-				font-size: 20px;
-			}
-			p {
-				color: #ffffff;
-				font-size: 15px;
-				line-height: 1.6;
-				margin-bottom: 30px;
-			}
-			.button-container {
-				margin: 30px 0;
-			}
-			.button {
-				display: inline-block;
-				padding: 14px 32px;
-				background-color:rgb(212, 45, 45);
-				color: #ffffff;
-				text-decoration: none;
-				font-weight: 600;
-				font-size: 15px;
-				border-radius: 10px;
-				transition: background-color 0.3s ease;
-			}
-			.button:hover {
-				background-color:rgba(212, 45, 45, 0.42);
-			}
-			.footer {
-				font-size: 12px;
-				color: #52525b;
-				margin-top: 40px;
-				border-top: 1px solid #27272a;
-				padding-top: 20px;
-			}
-			.link {
-				color: #2dd4bf;
-				text-decoration: none;
-			}
-		</style>
-	</head>
-	<body>
-		<div class="wrapper">
-			<div class="container">
-				<div class="logo">
-					<span class="logo-white">BAZA</span><span class="logo-teal">KURSOV</span>
-				</div>
-
-				<h3>%s</h3>
-				
-				<p>%s</p>
-				
-				<div class="button-container">
-					<a href="%s" class="button" target="_blank">%s</a>
-				</div>
-				
-				<p style="margin-bottom: 0;">Если кнопка не работает, скопируйте ссылку в браузер:</p>
-				<p style="font-size: 12px; word-break: break-all; margin-top: 10px;">
-					<a href="%s" class="link">%s</a>
-				</p>
-
-				<div class="footer">
-					Если вы не запрашивали это действие, просто проигнорируйте письмо. Ваш аккаунт в безопасности.
-				</div>
-			</div>
-		</div>
-	</body>
-	</html>
-	`, header, header, message, link, buttonText, link, link)
+	return s.outbox.Enqueue(ctx, &domain.EmailOutbox{
+		Kind:            kind,
+		TemplateVersion: s.templates.Version(),
+		To:              toEmail,
+		Subject:         msg.Subject,
+		HTML:            msg.HTML,
+		Text:            msg.Text,
+	})
 }
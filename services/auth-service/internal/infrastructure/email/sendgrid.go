@@ -0,0 +1,85 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// SendGridMailer отправляет письма через SendGrid API
+type SendGridMailer struct {
+	apiKey     string
+	from       string
+	fromName   string
+	httpClient *http.Client
+}
+
+func NewSendGridMailer(apiKey, from, fromName string) *SendGridMailer {
+	return &SendGridMailer{
+		apiKey:     apiKey,
+		from:       from,
+		fromName:   fromName,
+		httpClient: &http.Client{Timeout: 15 * time.Second},
+	}
+}
+
+// Структуры для API SendGrid
+type sgEmail struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+type sgContent struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+type sgPersonalization struct {
+	To []sgEmail `json:"to"`
+}
+type sgRequest struct {
+	Personalizations []sgPersonalization `json:"personalizations"`
+	From             sgEmail             `json:"from"`
+	Subject          string              `json:"subject"`
+	Content          []sgContent         `json:"content"`
+}
+
+func (m *SendGridMailer) Send(ctx context.Context, msg Message) error {
+	body := sgRequest{
+		Personalizations: []sgPersonalization{{To: []sgEmail{{Email: msg.To}}}},
+		From:             sgEmail{Email: m.from, Name: m.fromName},
+		Subject:          msg.Subject,
+	}
+	// SendGrid требует text/plain перед text/html
+	if msg.Text != "" {
+		body.Content = append(body.Content, sgContent{Type: "text/plain", Value: msg.Text})
+	}
+	body.Content = append(body.Content, sgContent{Type: "text/html", Value: msg.HTML})
+
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.sendgrid.com/v3/mail/send", bytes.NewReader(bodyBytes))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+m.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// SendGrid возвращает 200, 201 или 202 при успехе
+	if resp.StatusCode >= 400 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("sendgrid error: status=%d body=%s", resp.StatusCode, respBody)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package email
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	htmltemplate "html/template"
+	"io/fs"
+	"sort"
+	"strings"
+	texttemplate "text/template"
+	"time"
+)
+
+// Виды писем; у каждого в каждом языке есть templates/<locale>/<kind>.tmpl
+const (
+	KindResetPassword = "reset_password"
+	KindVerifyEmail   = "verify_email"
+	KindUnlockAccount = "unlock_account"
+	KindEmailChange   = "email_change"
+	KindDeleteAccount = "delete_account"
+	KindNewDevice     = "new_device"
+	KindMagicLink     = "magic_link"
+	// Удаление подтверждено, идет льготный период
+	KindAccountDeletionScheduled = "account_deletion_scheduled"
+)
+
+var Kinds = []string{KindResetPassword, KindVerifyEmail, KindUnlockAccount, KindEmailChange,
+	KindDeleteAccount, KindAccountDeletionScheduled, KindNewDevice, KindMagicLink}
+
+// DefaultLocale — язык, если у пользователя не задан или для него нет шаблонов
+const DefaultLocale = "ru"
+
+//go:embed templates
+var templateFS embed.FS
+
+// Brand — оформление писем из конфига
+type Brand struct {
+	Name    string
+	Color   string // Цвет кнопки и логотипа, например #d42d2d
+	LogoURL string // Пусто — вместо картинки название текстом
+}
+
+// Params — данные конкретного письма
+type Params struct {
+	Link string
+	// Срок, до которого действует ссылка или предложение (например, отмена удаления аккаунта)
+	Until time.Time
+	// Подробности входа для письма о новом устройстве
+	Login LoginDetails
+}
+
+type LoginDetails struct {
+	Device    string
+	IP        string
+	UserAgent string
+	At        time.Time
+}
+
+// TemplateData — то, что доступно в шаблонах
+type TemplateData struct {
+	Params
+	Brand  Brand
+	Locale string
+}
+
+// Rendered — готовое письмо в двух вариантах
+type Rendered struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// Templates — шаблоны писем на всех языках. Version меняется при любой правке файлов шаблонов
+// и сохраняется в outbox, чтобы было видно, какой версией собрано письмо.
+type Templates struct {
+	brand   Brand
+	html    map[string]*htmltemplate.Template
+	text    map[string]*texttemplate.Template
+	locales []string
+	version string
+}
+
+func NewTemplates(brand Brand) (*Templates, error) {
+	t := &Templates{
+		brand: brand,
+		html:  map[string]*htmltemplate.Template{},
+		text:  map[string]*texttemplate.Template{},
+	}
+
+	entries, err := fs.ReadDir(templateFS, "templates")
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		locale := e.Name()
+		for _, kind := range Kinds {
+			files := []string{"templates/" + locale + "/common.tmpl", "templates/" + locale + "/" + kind + ".tmpl"}
+
+			h, err := htmltemplate.ParseFS(templateFS, append([]string{"templates/layout.html"}, files...)...)
+			if err != nil {
+				return nil, fmt.Errorf("%s/%s: %w", locale, kind, err)
+			}
+			x, err := texttemplate.ParseFS(templateFS, append([]string{"templates/layout.txt"}, files...)...)
+			if err != nil {
+				return nil, fmt.Errorf("%s/%s: %w", locale, kind, err)
+			}
+			t.html[locale+"/"+kind], t.text[locale+"/"+kind] = h, x
+		}
+		t.locales = append(t.locales, locale)
+	}
+	sort.Strings(t.locales)
+
+	if t.version, err = templatesVersion(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *Templates) Locales() []string {
+	return t.locales
+}
+
+func (t *Templates) Version() string {
+	return t.version
+}
+
+// Render собирает письмо. Неизвестный язык заменяется на DefaultLocale.
+func (t *Templates) Render(kind, locale string, p Params) (*Rendered, error) {
+	if _, ok := t.html[locale+"/"+kind]; !ok {
+		locale = DefaultLocale
+	}
+	h, ok := t.html[locale+"/"+kind]
+	if !ok {
+		return nil, fmt.Errorf("no template %s for locale %s", kind, locale)
+	}
+	x := t.text[locale+"/"+kind]
+
+	data := TemplateData{Params: p, Brand: t.brand, Locale: locale}
+	var subject, html, text bytes.Buffer
+	if err := x.ExecuteTemplate(&subject, "subject", data); err != nil {
+		return nil, err
+	}
+	if err := h.ExecuteTemplate(&html, "layout", data); err != nil {
+		return nil, err
+	}
+	if err := x.ExecuteTemplate(&text, "layout", data); err != nil {
+		return nil, err
+	}
+
+	return &Rendered{
+		Subject: strings.TrimSpace(subject.String()),
+		HTML:    html.String(),
+		Text:    text.String(),
+	}, nil
+}
+
+// templatesVersion — короткий хэш содержимого всех файлов шаблонов
+func templatesVersion() (string, error) {
+	sum := sha256.New()
+	err := fs.WalkDir(templateFS, "templates", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := templateFS.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		sum.Write([]byte(path))
+		sum.Write(data)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(sum.Sum(nil))[:12], nil
+}
@@ -0,0 +1,74 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"time"
+)
+
+var ErrUnknownProvider = errors.New("unknown identity provider")
+
+// Identity — пользователь с точки зрения внешнего провайдера
+type Identity struct {
+	Provider      string
+	Subject       string // Стабильный ID у провайдера (sub в OIDC)
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// Callback — то, что провайдер вернул в redirect_uri
+type Callback struct {
+	Code     string
+	DeviceID string // Только VK ID: без него не обменять код
+}
+
+// Provider — вход через authorization code + PKCE (RFC 7636)
+type Provider interface {
+	Name() string
+	// AuthURL — куда отправить пользователя. codeChallenge — S256 от verifier,
+	// nonce привязывает id_token к этому входу (провайдеры без id_token его не используют)
+	AuthURL(ctx context.Context, state, codeChallenge, nonce string) (string, error)
+	// Exchange меняет код на данные пользователя
+	Exchange(ctx context.Context, cb Callback, codeVerifier, nonce string) (*Identity, error)
+}
+
+// Registry — включенные провайдеры по имени
+type Registry map[string]Provider
+
+func (r Registry) Get(name string) (Provider, error) {
+	p, ok := r[name]
+	if !ok {
+		return nil, ErrUnknownProvider
+	}
+	return p, nil
+}
+
+func (r Registry) Add(p Provider) {
+	r[p.Name()] = p
+}
+
+var httpClient = &http.Client{Timeout: 10 * time.Second}
+
+// NewVerifier возвращает code_verifier и code_challenge (S256) для PKCE
+func NewVerifier() (string, string, error) {
+	verifier, err := RandomToken(32)
+	if err != nil {
+		return "", "", err
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	return verifier, base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+// RandomToken — случайная строка для state, nonce и code_verifier
+func RandomToken(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,95 @@
+package oauth
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const vkIDBase = "https://id.vk.com"
+
+// VKProvider — VK ID (OAuth 2.1). Это не OIDC: данные пользователя берем из user_info,
+// а для обмена кода нужен device_id, который VK возвращает в redirect_uri.
+type VKProvider struct {
+	clientID     string
+	clientSecret string
+	redirectURL  string
+}
+
+func NewVKProvider(clientID, clientSecret, redirectURL string) *VKProvider {
+	return &VKProvider{clientID: clientID, clientSecret: clientSecret, redirectURL: redirectURL}
+}
+
+func (p *VKProvider) Name() string {
+	return "vk"
+}
+
+func (p *VKProvider) AuthURL(_ context.Context, state, codeChallenge, _ string) (string, error) {
+	q := url.Values{}
+	q.Set("response_type", "code")
+	q.Set("client_id", p.clientID)
+	q.Set("redirect_uri", p.redirectURL)
+	q.Set("scope", "email")
+	q.Set("state", state)
+	q.Set("code_challenge", codeChallenge)
+	q.Set("code_challenge_method", "S256")
+	return vkIDBase + "/authorize?" + q.Encode(), nil
+}
+
+func (p *VKProvider) Exchange(ctx context.Context, cb Callback, codeVerifier, _ string) (*Identity, error) {
+	if cb.DeviceID == "" {
+		return nil, errors.New("vk: device_id is required")
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", cb.Code)
+	form.Set("code_verifier", codeVerifier)
+	form.Set("client_id", p.clientID)
+	form.Set("client_secret", p.clientSecret)
+	form.Set("device_id", cb.DeviceID)
+	form.Set("redirect_uri", p.redirectURL)
+
+	var tok struct {
+		AccessToken string `json:"access_token"`
+		UserID      int64  `json:"user_id"`
+	}
+	if err := postForm(ctx, vkIDBase+"/oauth2/auth", form, &tok); err != nil {
+		return nil, err
+	}
+
+	info := url.Values{}
+	info.Set("client_id", p.clientID)
+	info.Set("access_token", tok.AccessToken)
+
+	var res struct {
+		User struct {
+			UserID    string `json:"user_id"`
+			FirstName string `json:"first_name"`
+			LastName  string `json:"last_name"`
+			Email     string `json:"email"`
+		} `json:"user"`
+	}
+	if err := postForm(ctx, vkIDBase+"/oauth2/user_info", info, &res); err != nil {
+		return nil, err
+	}
+
+	subject := res.User.UserID
+	if subject == "" {
+		subject = strconv.FormatInt(tok.UserID, 10)
+	}
+	if subject == "" || subject == "0" {
+		return nil, errors.New("vk: no user id")
+	}
+
+	return &Identity{
+		Provider: p.Name(),
+		Subject:  subject,
+		Email:    res.User.Email,
+		// VK не сообщает, подтверждена ли почта, поэтому по ней не связываем
+		EmailVerified: false,
+		Name:          strings.TrimSpace(res.User.FirstName + " " + res.User.LastName),
+	}, nil
+}
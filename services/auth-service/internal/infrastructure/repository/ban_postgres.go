@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"auth-service/internal/domain"
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type BanRepository struct {
+	db *gorm.DB
+}
+
+func NewBanRepository(db *gorm.DB) *BanRepository {
+	return &BanRepository{db: db}
+}
+
+// Active — действующий бан пользователя или nil
+func (r *BanRepository) Active(ctx context.Context, userID uuid.UUID) (*domain.Ban, error) {
+	var ban domain.Ban
+	err := r.db.WithContext(ctx).
+		Where("user_id = ? AND lifted_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", userID, time.Now()).
+		Order("created_at desc").
+		First(&ban).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ban, nil
+}
+
+// Replace снимает действующие баны и сохраняет новый в одной транзакции:
+// повторный бан меняет срок и причину, а не копит записи
+func (r *BanRepository) Replace(ctx context.Context, ban *domain.Ban) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if _, err := liftActive(tx, ban.UserID, ban.BannedBy, ban.CreatedAt); err != nil {
+			return err
+		}
+		return tx.Create(ban).Error
+	})
+}
+
+// Lift снимает действующие баны; false — снимать было нечего
+func (r *BanRepository) Lift(ctx context.Context, userID, liftedBy uuid.UUID) (bool, error) {
+	n, err := liftActive(r.db.WithContext(ctx), userID, liftedBy, time.Now())
+	return n > 0, err
+}
+
+func liftActive(tx *gorm.DB, userID, liftedBy uuid.UUID, at time.Time) (int64, error) {
+	res := tx.Model(&domain.Ban{}).
+		Where("user_id = ? AND lifted_at IS NULL AND (expires_at IS NULL OR expires_at > ?)", userID, at).
+		Updates(map[string]interface{}{"lifted_at": at, "lifted_by": liftedBy})
+	return res.RowsAffected, res.Error
+}
+
+// List — все баны пользователя, новые первыми
+func (r *BanRepository) List(ctx context.Context, userID uuid.UUID) ([]domain.Ban, error) {
+	var bans []domain.Ban
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at desc").
+		Find(&bans).Error
+	return bans, err
+}
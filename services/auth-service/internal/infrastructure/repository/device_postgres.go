@@ -58,3 +58,27 @@ func (r *DeviceRepository) Delete(ctx context.Context, userID uuid.UUID, deviceI
 		Where("user_id = ? AND device_id = ?", userID, deviceID).
 		Delete(&domain.Device{}).Error
 }
+
+func (r *DeviceRepository) DeleteAll(ctx context.Context, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&domain.Device{}).Error
+}
+
+// Touch отмечает активность устройства (при обновлении токенов)
+func (r *DeviceRepository) Touch(ctx context.Context, userID uuid.UUID, deviceID string) error {
+	return r.db.WithContext(ctx).Model(&domain.Device{}).
+		Where("user_id = ? AND device_id = ?", userID, deviceID).
+		Update("last_active_at", time.Now()).Error
+}
+
+// ListInactive — устройства всех пользователей, которыми не пользовались с before
+func (r *DeviceRepository) ListInactive(ctx context.Context, before time.Time, limit int) ([]domain.Device, error) {
+	var devices []domain.Device
+	err := r.db.WithContext(ctx).
+		Where("last_active_at < ?", before).
+		Order("last_active_at").
+		Limit(limit).
+		Find(&devices).Error
+	return devices, err
+}
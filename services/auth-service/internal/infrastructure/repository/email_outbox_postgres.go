@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"auth-service/internal/domain"
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type EmailOutboxRepository struct {
+	db *gorm.DB
+}
+
+func NewEmailOutboxRepository(db *gorm.DB) *EmailOutboxRepository {
+	return &EmailOutboxRepository{db: db}
+}
+
+func (r *EmailOutboxRepository) Enqueue(ctx context.Context, msg *domain.EmailOutbox) error {
+	msg.Status = domain.EmailPending
+	msg.NextAttemptAt = time.Now()
+	return r.db.WithContext(ctx).Create(msg).Error
+}
+
+// Claim забирает письма, которые пора отправить, и откладывает их на lease,
+// чтобы другая реплика не отправила то же письмо, пока эта с ним работает
+func (r *EmailOutboxRepository) Claim(ctx context.Context, limit int, lease time.Duration) ([]domain.EmailOutbox, error) {
+	var items []domain.EmailOutbox
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND next_attempt_at <= ?", domain.EmailPending, time.Now()).
+			Order("next_attempt_at").
+			Limit(limit).
+			Find(&items).Error
+		if err != nil || len(items) == 0 {
+			return err
+		}
+
+		ids := make([]uint, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+		return tx.Model(&domain.EmailOutbox{}).
+			Where("id IN ?", ids).
+			Update("next_attempt_at", time.Now().Add(lease)).Error
+	})
+	return items, err
+}
+
+func (r *EmailOutboxRepository) MarkSent(ctx context.Context, id uint, attempts int) error {
+	return r.db.WithContext(ctx).Model(&domain.EmailOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     domain.EmailSent,
+			"attempts":   attempts,
+			"sent_at":    time.Now(),
+			"last_error": "",
+		}).Error
+}
+
+func (r *EmailOutboxRepository) Reschedule(ctx context.Context, id uint, attempts int, next time.Time, lastErr string) error {
+	return r.db.WithContext(ctx).Model(&domain.EmailOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"attempts":        attempts,
+			"next_attempt_at": next,
+			"last_error":      lastErr,
+		}).Error
+}
+
+func (r *EmailOutboxRepository) MarkDead(ctx context.Context, id uint, attempts int, lastErr string) error {
+	return r.db.WithContext(ctx).Model(&domain.EmailOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"status":     domain.EmailDead,
+			"attempts":   attempts,
+			"last_error": lastErr,
+		}).Error
+}
+
+// PurgeSent удаляет отправленные письма старше before: в них ссылки с токенами, хранить их незачем
+func (r *EmailOutboxRepository) PurgeSent(ctx context.Context, before time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).
+		Where("status = ? AND sent_at < ?", domain.EmailSent, before).
+		Delete(&domain.EmailOutbox{})
+	return res.RowsAffected, res.Error
+}
+
+// PurgeDead удаляет dead-письма, созданные до before: ссылки в них давно истекли, а токены хранить незачем
+func (r *EmailOutboxRepository) PurgeDead(ctx context.Context, before time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).
+		Where("status = ? AND created_at < ?", domain.EmailDead, before).
+		Delete(&domain.EmailOutbox{})
+	return res.RowsAffected, res.Error
+}
+
+func (r *EmailOutboxRepository) ListDead(ctx context.Context, limit int) ([]domain.EmailOutbox, error) {
+	var items []domain.EmailOutbox
+	err := r.db.WithContext(ctx).
+		Where("status = ?", domain.EmailDead).
+		Order("id DESC").
+		Limit(limit).
+		Find(&items).Error
+	return items, err
+}
+
+// Requeue возвращает dead-письмо в очередь с нуля попыток
+func (r *EmailOutboxRepository) Requeue(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Model(&domain.EmailOutbox{}).
+		Where("id = ? AND status = ?", id, domain.EmailDead).
+		Updates(map[string]interface{}{
+			"status":          domain.EmailPending,
+			"attempts":        0,
+			"next_attempt_at": time.Now(),
+		}).Error
+}
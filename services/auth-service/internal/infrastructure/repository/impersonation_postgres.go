@@ -0,0 +1,32 @@
+package repository
+
+import (
+	"auth-service/internal/domain"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ImpersonationAuditRepository struct {
+	db *gorm.DB
+}
+
+func NewImpersonationAuditRepository(db *gorm.DB) *ImpersonationAuditRepository {
+	return &ImpersonationAuditRepository{db: db}
+}
+
+func (r *ImpersonationAuditRepository) Add(ctx context.Context, entry *domain.ImpersonationAudit) error {
+	return r.db.WithContext(ctx).Create(entry).Error
+}
+
+// List — последние limit записей по пользователю, которого имперсонировали, новые первыми
+func (r *ImpersonationAuditRepository) List(ctx context.Context, userID uuid.UUID, limit int) ([]domain.ImpersonationAudit, error) {
+	var entries []domain.ImpersonationAudit
+	err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at desc, id desc").
+		Limit(limit).
+		Find(&entries).Error
+	return entries, err
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"auth-service/internal/domain"
+	"context"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type LoginHistoryRepository struct {
+	db *gorm.DB
+}
+
+func NewLoginHistoryRepository(db *gorm.DB) *LoginHistoryRepository {
+	return &LoginHistoryRepository{db: db}
+}
+
+func (r *LoginHistoryRepository) Add(ctx context.Context, event *domain.LoginEvent) error {
+	return r.db.WithContext(ctx).Create(event).Error
+}
+
+// List — последние limit записей, новые первыми. limit <= 0 — вся история (для выгрузки данных).
+func (r *LoginHistoryRepository) List(ctx context.Context, userID uuid.UUID, limit int) ([]domain.LoginEvent, error) {
+	var events []domain.LoginEvent
+	q := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("created_at desc, id desc")
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	err := q.Find(&events).Error
+	return events, err
+}
+
+// HasSuccessfulLogin — входил ли пользователь успешно хоть раз (с любого устройства или с deviceID)
+func (r *LoginHistoryRepository) HasSuccessfulLogin(ctx context.Context, userID uuid.UUID, deviceID string) (bool, error) {
+	q := r.db.WithContext(ctx).Model(&domain.LoginEvent{}).
+		Where("user_id = ? AND success", userID)
+	if deviceID != "" {
+		q = q.Where("device_id = ?", deviceID)
+	}
+	var count int64
+	err := q.Count(&count).Error
+	return count > 0, err
+}
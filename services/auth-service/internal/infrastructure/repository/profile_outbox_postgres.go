@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"auth-service/internal/domain"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ProfileOutboxRepository struct {
+	db *gorm.DB
+}
+
+func NewProfileOutboxRepository(db *gorm.DB) *ProfileOutboxRepository {
+	return &ProfileOutboxRepository{db: db}
+}
+
+// Due — записи, время повторной попытки которых уже наступило
+func (r *ProfileOutboxRepository) Due(ctx context.Context, limit int) ([]domain.ProfileOutbox, error) {
+	var items []domain.ProfileOutbox
+	err := r.db.WithContext(ctx).
+		Where("next_attempt_at <= ?", time.Now()).
+		Order("next_attempt_at").
+		Limit(limit).
+		Find(&items).Error
+	return items, err
+}
+
+func (r *ProfileOutboxRepository) Enqueue(ctx context.Context, item *domain.ProfileOutbox) error {
+	item.NextAttemptAt = time.Now()
+	return r.db.WithContext(ctx).
+		Where(domain.ProfileOutbox{UserID: item.UserID}).
+		FirstOrCreate(item).Error
+}
+
+func (r *ProfileOutboxRepository) Reschedule(ctx context.Context, id uint, attempts int, next time.Time, lastErr string) error {
+	return r.db.WithContext(ctx).Model(&domain.ProfileOutbox{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"attempts":        attempts,
+			"next_attempt_at": next,
+			"last_error":      lastErr,
+		}).Error
+}
+
+func (r *ProfileOutboxRepository) Delete(ctx context.Context, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&domain.ProfileOutbox{}).Error
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"auth-service/internal/domain"
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type RoleRepository struct {
+	db *gorm.DB
+}
+
+func NewRoleRepository(db *gorm.DB) *RoleRepository {
+	return &RoleRepository{db: db}
+}
+
+// Roles — роли пользователя; без выданных ролей — только RoleUser
+func (r *RoleRepository) Roles(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	var roles []string
+	err := r.db.WithContext(ctx).Model(&domain.UserRole{}).
+		Where("user_id = ?", userID).
+		Order("role").
+		Pluck("role", &roles).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		roles = []string{domain.RoleUser}
+	}
+	return roles, nil
+}
+
+// Grant выдает роль. false — роль у пользователя уже была.
+func (r *RoleRepository) Grant(ctx context.Context, userID uuid.UUID, role string, grantedBy *uuid.UUID) (bool, error) {
+	res := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&domain.UserRole{UserID: userID, Role: role, GrantedBy: grantedBy, CreatedAt: time.Now()})
+	return res.RowsAffected > 0, res.Error
+}
+
+// Revoke отзывает роль. false — такой роли не было.
+func (r *RoleRepository) Revoke(ctx context.Context, userID uuid.UUID, role string) (bool, error) {
+	res := r.db.WithContext(ctx).
+		Where("user_id = ? AND role = ?", userID, role).
+		Delete(&domain.UserRole{})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *RoleRepository) CountWithRole(ctx context.Context, role string) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.UserRole{}).
+		Where("role = ?", role).
+		Count(&count).Error
+	return count, err
+}
@@ -9,16 +9,21 @@ import (
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // GORM Модель
 type UserGorm struct {
-	ID        uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
-	Username  string    `gorm:"uniqueIndex;not null;size:50"`
-	Email     string    `gorm:"uniqueIndex;not null;size:100"`
-	Password  string    `gorm:"not null"`
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID              uuid.UUID `gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Username        string    `gorm:"uniqueIndex;not null;size:50"`
+	Email           string    `gorm:"uniqueIndex;not null;size:100"`
+	Password        string    `gorm:"not null"`
+	EmailVerifiedAt *time.Time
+	TOTPSecret      string     `gorm:"column:totp_secret"`
+	TOTPEnabledAt   *time.Time `gorm:"column:totp_enabled_at"`
+	DeleteAfter     *time.Time `gorm:"index"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 func (UserGorm) TableName() string {
@@ -27,12 +32,16 @@ func (UserGorm) TableName() string {
 
 func (ug *UserGorm) ToDomain() *domain.User {
 	return &domain.User{
-		ID:        ug.ID,
-		Username:  ug.Username,
-		Email:     ug.Email,
-		Password:  ug.Password,
-		CreatedAt: ug.CreatedAt,
-		UpdatedAt: ug.UpdatedAt,
+		ID:              ug.ID,
+		Username:        ug.Username,
+		Email:           ug.Email,
+		Password:        ug.Password,
+		EmailVerifiedAt: ug.EmailVerifiedAt,
+		TOTPSecret:      ug.TOTPSecret,
+		TOTPEnabledAt:   ug.TOTPEnabledAt,
+		DeleteAfter:     ug.DeleteAfter,
+		CreatedAt:       ug.CreatedAt,
+		UpdatedAt:       ug.UpdatedAt,
 	}
 }
 
@@ -62,11 +71,81 @@ func (r *UserRepository) Create(ctx context.Context, user *domain.User) error {
 	return nil
 }
 
+// CreateWithProfileOutbox создает пользователя и задачу на создание профиля в одной транзакции:
+// если User Service недоступен, профиль досоздаст воркер, и пользователь не останется без него.
+// identity (может быть nil) — внешний аккаунт, через который пользователь зарегистрировался.
+func (r *UserRepository) CreateWithProfileOutbox(ctx context.Context, user *domain.User, identity *domain.ExternalIdentity) error {
+	gormUser := &UserGorm{
+		ID:              user.ID,
+		Username:        user.Username,
+		Email:           user.Email,
+		Password:        user.Password,
+		EmailVerifiedAt: user.EmailVerifiedAt,
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(gormUser).Error; err != nil {
+			return err
+		}
+		if identity != nil {
+			identity.UserID = user.ID
+			if err := tx.Create(identity).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(&domain.ProfileOutbox{
+			UserID:        user.ID,
+			Email:         user.Email,
+			Username:      user.Username,
+			EmailVerified: user.EmailVerified(),
+			NextAttemptAt: time.Now(),
+		}).Error
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return domain.ErrUserAlreadyExists
+	}
+	return err
+}
+
+// ListAfter отдает пользователей постранично по возрастанию ID (для сверки с User Service)
+func (r *UserRepository) ListAfter(ctx context.Context, after uuid.UUID, limit int) ([]domain.User, error) {
+	var models []UserGorm
+	err := r.db.WithContext(ctx).
+		Where("id > ?", after).
+		Order("id").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]domain.User, 0, len(models))
+	for _, m := range models {
+		users = append(users, *m.ToDomain())
+	}
+	return users, nil
+}
+
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*domain.User, error) {
 	var userModel UserGorm
 	err := r.db.WithContext(ctx).Where("email = ?", email).First(&userModel).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrUserNotFound
+	}
 	if err != nil {
-		return nil, err // GORM вернет ErrRecordNotFound, обработаем в UseCase
+		return nil, err
+	}
+	return userModel.ToDomain(), nil
+}
+
+func (r *UserRepository) GetByID(ctx context.Context, userID uuid.UUID) (*domain.User, error) {
+	var userModel UserGorm
+	err := r.db.WithContext(ctx).Where("id = ?", userID).First(&userModel).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, domain.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
 	}
 	return userModel.ToDomain(), nil
 }
@@ -78,8 +157,139 @@ func (r *UserRepository) UpdatePassword(ctx context.Context, userID uuid.UUID, n
 		Update("password", newPassword).Error
 }
 
+// MarkEmailVerified ставит время подтверждения, если его еще нет
+func (r *UserRepository) MarkEmailVerified(ctx context.Context, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Model(&UserGorm{}).
+		Where("id = ? AND email_verified_at IS NULL", userID).
+		Update("email_verified_at", time.Now()).Error
+}
+
 func (r *UserRepository) UpdateEmail(ctx context.Context, userID uuid.UUID, newEmail string) error {
 	return r.db.WithContext(ctx).Model(&UserGorm{}).
 		Where("id = ?", userID).
 		Update("email", newEmail).Error
 }
+
+// EnableTOTP включает 2FA и заменяет коды восстановления в одной транзакции
+func (r *UserRepository) EnableTOTP(ctx context.Context, userID uuid.UUID, secret string, recoveryHashes []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&UserGorm{}).Where("id = ?", userID).Updates(map[string]interface{}{
+			"totp_secret":     secret,
+			"totp_enabled_at": time.Now(),
+		}).Error; err != nil {
+			return err
+		}
+		return replaceRecoveryCodes(tx, userID, recoveryHashes)
+	})
+}
+
+func (r *UserRepository) DisableTOTP(ctx context.Context, userID uuid.UUID) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&UserGorm{}).Where("id = ?", userID).Updates(map[string]interface{}{
+			"totp_secret":     "",
+			"totp_enabled_at": nil,
+		}).Error; err != nil {
+			return err
+		}
+		return tx.Where("user_id = ?", userID).Delete(&domain.RecoveryCode{}).Error
+	})
+}
+
+func (r *UserRepository) ReplaceRecoveryCodes(ctx context.Context, userID uuid.UUID, hashes []string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return replaceRecoveryCodes(tx, userID, hashes)
+	})
+}
+
+// UseRecoveryCode помечает код использованным. false — кода нет или он уже потрачен.
+func (r *UserRepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, hash string) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&domain.RecoveryCode{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, hash).
+		Update("used_at", time.Now())
+	return res.RowsAffected == 1, res.Error
+}
+
+func (r *UserRepository) CountRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.RecoveryCode{}).
+		Where("user_id = ? AND used_at IS NULL", userID).
+		Count(&count).Error
+	return count, err
+}
+
+// ScheduleDeletion ставит время окончательного удаления, если удаление еще не запланировано.
+// false — уже запланировано раньше.
+func (r *UserRepository) ScheduleDeletion(ctx context.Context, userID uuid.UUID, at time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&UserGorm{}).
+		Where("id = ? AND delete_after IS NULL", userID).
+		Update("delete_after", at)
+	return res.RowsAffected == 1, res.Error
+}
+
+// CancelDeletion снимает удаление, пока льготный период идет. false — он уже кончился,
+// и стирание данных в других сервисах могло начаться
+func (r *UserRepository) CancelDeletion(ctx context.Context, userID uuid.UUID, now time.Time) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&UserGorm{}).
+		Where("id = ? AND delete_after > ?", userID, now).
+		Update("delete_after", nil)
+	return res.RowsAffected == 1, res.Error
+}
+
+// DueForDeletion — пользователи, у которых закончился льготный период
+func (r *UserRepository) DueForDeletion(ctx context.Context, now time.Time, limit int) ([]domain.User, error) {
+	var models []UserGorm
+	err := r.db.WithContext(ctx).
+		Where("delete_after IS NOT NULL AND delete_after <= ?", now).
+		Order("delete_after").
+		Limit(limit).
+		Find(&models).Error
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]domain.User, 0, len(models))
+	for _, m := range models {
+		users = append(users, *m.ToDomain())
+	}
+	return users, nil
+}
+
+// Purge стирает пользователя и все связанные с ним записи Auth Service в одной транзакции.
+// Письма на его адрес тоже удаляются: в них ссылки и токены. Пользователь, у которого
+// удаление снято или еще не наступило, не трогается — ErrDeletionNotDue.
+func (r *UserRepository) Purge(ctx context.Context, user *domain.User, now time.Time) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var due int64
+		err := tx.Model(&UserGorm{}).
+			Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND delete_after IS NOT NULL AND delete_after <= ?", user.ID, now).
+			Count(&due).Error
+		if err != nil {
+			return err
+		}
+		if due == 0 {
+			return domain.ErrDeletionNotDue
+		}
+
+		for _, model := range []interface{}{&domain.RecoveryCode{}, &domain.Device{}, &domain.ExternalIdentity{}, &domain.ProfileOutbox{}, &domain.LoginEvent{}, &domain.UserRole{}, &domain.APIKey{}, &domain.Ban{}} {
+			if err := tx.Where("user_id = ?", user.ID).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Where("to_email = ?", user.Email).Delete(&domain.EmailOutbox{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("id = ?", user.ID).Delete(&UserGorm{}).Error
+	})
+}
+
+func replaceRecoveryCodes(tx *gorm.DB, userID uuid.UUID, hashes []string) error {
+	if err := tx.Where("user_id = ?", userID).Delete(&domain.RecoveryCode{}).Error; err != nil {
+		return err
+	}
+	codes := make([]domain.RecoveryCode, 0, len(hashes))
+	for _, h := range hashes {
+		codes = append(codes, domain.RecoveryCode{UserID: userID, CodeHash: h, CreatedAt: time.Now()})
+	}
+	return tx.Create(&codes).Error
+}
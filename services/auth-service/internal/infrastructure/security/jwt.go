@@ -2,42 +2,81 @@ package security
 
 import (
 	"errors"
+	"math"
 	"time"
 
+	"auth-service/internal/domain"
+
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 type TokenManager struct {
-	accessSecret  []byte
-	refreshSecret []byte
+	keys       *KeyRing
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+// AccessClaims — то, что сервисы узнают о владельце access-токена
+type AccessClaims struct {
+	UserID   string
+	DeviceID string
+	JTI      string
+	MFA      bool // Вход подтвержден вторым фактором
+	Roles    []string
+	// Права ролей на момент выпуска токена; после смены ролей токены отзываются
+	Permissions []string
+	// Не пусто — токен выпущен сотруднику ActorID для входа от имени UserID (claim "act")
+	ActorID   string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
 }
 
-func NewTokenManager(accessSecret, refreshSecret string) *TokenManager {
+// RefreshClaims — данные, которые нужны для ротации refresh-токена
+type RefreshClaims struct {
+	UserID   string
+	FamilyID string
+	JTI      string
+}
+
+func NewTokenManager(keys *KeyRing, accessTTL, refreshTTL time.Duration) *TokenManager {
 	return &TokenManager{
-		accessSecret:  []byte(accessSecret),
-		refreshSecret: []byte(refreshSecret),
+		keys:       keys,
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
 	}
 }
 
-func (m *TokenManager) Generate(userID string) (string, string, error) {
-	// Access (15 min)
-	at := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"sub":  userID,
-		"exp":  time.Now().Add(14 * 24 * time.Hour).Unix(),
-		"type": "access",
+// Generate выпускает пару токенов для сессии. Refresh-токен привязан к семейству
+// сессии и получает её текущий jti, чтобы повторное предъявление можно было отличить.
+// Роли и права попадают только в access-токен: при ротации их читаем заново.
+func (m *TokenManager) Generate(s *domain.Session, roles []string) (string, string, error) {
+	now := time.Now()
+
+	// Access живет недолго: отозвать его можно только через denylist
+	accessToken, err := m.keys.sign(jwt.MapClaims{
+		"sub":   s.UserID,
+		"did":   s.DeviceID,
+		"jti":   uuid.New().String(),
+		"amr":   authMethods(s),
+		"roles": roles,
+		"perms": domain.Permissions(roles),
+		"iat":   issuedAtClaim(now),
+		"exp":   now.Add(m.accessTTL).Unix(),
+		"type":  "access",
 	})
-	accessToken, err := at.SignedString(m.accessSecret)
 	if err != nil {
 		return "", "", err
 	}
 
-	// Refresh (7 days)
-	rt := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"sub":  userID,
-		"exp":  time.Now().Add(7 * 24 * time.Hour).Unix(),
+	refreshToken, err := m.keys.sign(jwt.MapClaims{
+		"sub":  s.UserID,
+		"fam":  s.ID,
+		"jti":  s.JTI,
+		"iat":  now.Unix(),
+		"exp":  now.Add(m.refreshTTL).Unix(),
 		"type": "refresh",
 	})
-	refreshToken, err := rt.SignedString(m.refreshSecret)
 	if err != nil {
 		return "", "", err
 	}
@@ -45,27 +84,166 @@ func (m *TokenManager) Generate(userID string) (string, string, error) {
 	return accessToken, refreshToken, nil
 }
 
-func (m *TokenManager) ValidateAccessToken(tokenStr string) (string, error) {
-	return m.validate(tokenStr, m.accessSecret)
+// GenerateImpersonation выпускает access-токен пользователя userID для сотрудника actorID
+// (claim "act", RFC 8693). Refresh-токена нет: по истечении ttl нужно запросить новый.
+func (m *TokenManager) GenerateImpersonation(userID, actorID string, roles []string, ttl time.Duration) (string, string, time.Time, error) {
+	now := time.Now()
+	jti := uuid.New().String()
+	expiresAt := now.Add(ttl)
+
+	token, err := m.keys.sign(jwt.MapClaims{
+		"sub":   userID,
+		"act":   map[string]string{"sub": actorID},
+		"jti":   jti,
+		"amr":   []string{"imp"},
+		"roles": roles,
+		"perms": domain.Permissions(roles),
+		"iat":   issuedAtClaim(now),
+		"exp":   expiresAt.Unix(),
+		"type":  "access",
+	})
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+	return token, jti, expiresAt, nil
 }
 
-func (m *TokenManager) ValidateRefreshToken(tokenStr string) (string, error) {
-	return m.validate(tokenStr, m.refreshSecret)
+// actor — sub из claim "act"
+func actor(claims jwt.MapClaims) string {
+	act, _ := claims["act"].(map[string]interface{})
+	sub, _ := act["sub"].(string)
+	return sub
+}
+
+// authMethods — claim "amr" (RFC 8176): как пользователь подтвердил вход
+func authMethods(s *domain.Session) []string {
+	amr := []string{firstFactor(s.Method)}
+	if s.MFA {
+		amr = append(amr, "otp", "mfa")
+	}
+	return amr
+}
+
+// firstFactor — значение "amr" для способа входа. Telegram и OAuth-провайдеры — внешний
+// вход ("fed"); у сессий, начатых до появления Method, способ неизвестен, и это был пароль
+func firstFactor(method string) string {
+	switch method {
+	case domain.LoginMethodPassword, "":
+		return "pwd"
+	case domain.LoginMethodMagicLink:
+		return "email"
+	default:
+		return "fed"
+	}
 }
 
-func (m *TokenManager) validate(tokenStr string, secret []byte) (string, error) {
-	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
+// issuedAtClaim — "iat" access-токена с миллисекундами: отзыв сравнивает его с моментом
+// отзыва, и токен, выпущенный в ту же секунду сразу после отзыва, должен остаться рабочим
+func issuedAtClaim(t time.Time) float64 {
+	return float64(t.UnixMilli()) / 1000
+}
+
+// issuedAt читает "iat" без округления до секунд, которое делает claims.GetIssuedAt
+func issuedAt(claims jwt.MapClaims) (time.Time, bool) {
+	iat, ok := claims["iat"].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(int64(math.Round(iat * 1000))), true
+}
+
+// hasMFA ищет "mfa" в claim "amr"
+func hasMFA(claims jwt.MapClaims) bool {
+	amr, _ := claims["amr"].([]interface{})
+	for _, m := range amr {
+		if m == "mfa" {
+			return true
 		}
-		return secret, nil
-	})
+	}
+	return false
+}
+
+// stringList читает claim-массив строк
+func stringList(claims jwt.MapClaims, name string) []string {
+	raw, _ := claims[name].([]interface{})
+	list := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if s, ok := v.(string); ok {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+// JWKS — публичные ключи для локальной проверки токенов другими сервисами
+func (m *TokenManager) JWKS() []JWK {
+	return m.keys.JWKS()
+}
+
+func (m *TokenManager) ValidateAccessToken(tokenStr string) (*AccessClaims, error) {
+	claims, err := m.parse(tokenStr, "access")
+	if err != nil {
+		return nil, err
+	}
+
+	did, _ := claims["did"].(string)
+	jti, _ := claims["jti"].(string)
+	iat, hasIat := issuedAt(claims)
+	exp, _ := claims.GetExpirationTime()
+	if jti == "" || !hasIat || exp == nil {
+		return nil, errors.New("invalid token")
+	}
+
+	return &AccessClaims{
+		UserID:      claims["sub"].(string),
+		DeviceID:    did,
+		JTI:         jti,
+		MFA:         hasMFA(claims),
+		Roles:       stringList(claims, "roles"),
+		Permissions: stringList(claims, "perms"),
+		ActorID:     actor(claims),
+		IssuedAt:    iat,
+		ExpiresAt:   exp.Time,
+	}, nil
+}
+
+func (m *TokenManager) ValidateRefreshToken(tokenStr string) (string, error) {
+	claims, err := m.ParseRefreshToken(tokenStr)
 	if err != nil {
 		return "", err
 	}
+	return claims.UserID, nil
+}
+
+// ParseRefreshToken проверяет подпись и достает из токена семейство и jti
+func (m *TokenManager) ParseRefreshToken(tokenStr string) (*RefreshClaims, error) {
+	claims, err := m.parse(tokenStr, "refresh")
+	if err != nil {
+		return nil, err
+	}
+
+	sub, _ := claims["sub"].(string)
+	fam, _ := claims["fam"].(string)
+	jti, _ := claims["jti"].(string)
+	if sub == "" || fam == "" || jti == "" {
+		return nil, errors.New("invalid token")
+	}
+	return &RefreshClaims{UserID: sub, FamilyID: fam, JTI: jti}, nil
+}
+
+// parse проверяет подпись и тип токена. Оба типа подписаны одной связкой ключей,
+// так что отличить refresh от access можно только по claim "type".
+func (m *TokenManager) parse(tokenStr, tokenType string) (jwt.MapClaims, error) {
+	token, err := jwt.Parse(tokenStr, m.keys.keyFunc,
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}))
+	if err != nil {
+		return nil, err
+	}
 
 	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		return claims["sub"].(string), nil
+		if _, ok := claims["sub"].(string); ok && claims["type"] == tokenType {
+			return claims, nil
+		}
 	}
-	return "", errors.New("invalid token")
+	return nil, errors.New("invalid token")
 }
@@ -0,0 +1,161 @@
+package security
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// signingKey — один ключ из связки. kid берется из имени файла.
+type signingKey struct {
+	kid     string
+	method  jwt.SigningMethod
+	private crypto.Signer
+}
+
+// JWK — публичная часть ключа в формате RFC 7517
+type JWK struct {
+	Kid string
+	Kty string
+	Alg string
+	Use string
+	N   string // RSA
+	E   string // RSA
+	Crv string // OKP
+	X   string // OKP
+}
+
+// KeyRing хранит все действующие ключи. Подписываем активным,
+// проверяем любым — так ключи можно ротировать, не разлогинивая пользователей.
+type KeyRing struct {
+	active *signingKey
+	keys   map[string]*signingKey
+}
+
+// LoadKeyRing читает приватные ключи (*.pem, PKCS#8 или PKCS#1) из dir.
+// Если activeKID пуст, подписываем ключом с наибольшим kid — файлы удобно называть датой.
+func LoadKeyRing(dir, activeKID string) (*KeyRing, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no signing keys in %s (run cmd/keygen)", dir)
+	}
+	sort.Strings(files)
+
+	ring := &KeyRing{keys: make(map[string]*signingKey)}
+	for _, f := range files {
+		key, err := loadKey(f)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f, err)
+		}
+		ring.keys[key.kid] = key
+		if activeKID == "" || key.kid == activeKID {
+			ring.active = key
+		}
+	}
+
+	if ring.active == nil {
+		return nil, fmt.Errorf("signing key %q not found in %s", activeKID, dir)
+	}
+	return ring, nil
+}
+
+func loadKey(path string) (*signingKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("not a PEM file")
+	}
+
+	var parsed interface{}
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	kid := strings.TrimSuffix(filepath.Base(path), ".pem")
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < 2048 {
+			return nil, errors.New("RSA key must be at least 2048 bits")
+		}
+		return &signingKey{kid: kid, method: jwt.SigningMethodRS256, private: k}, nil
+	case ed25519.PrivateKey:
+		return &signingKey{kid: kid, method: jwt.SigningMethodEdDSA, private: k}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+}
+
+// sign подписывает claims активным ключом и ставит kid в заголовок
+func (r *KeyRing) sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(r.active.method, claims)
+	token.Header["kid"] = r.active.kid
+	return token.SignedString(r.active.private)
+}
+
+// keyFunc подбирает ключ проверки по kid и следит, чтобы алгоритм совпадал с ключом
+func (r *KeyRing) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := r.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, errors.New("unexpected signing method")
+	}
+	return key.private.Public(), nil
+}
+
+// JWKS возвращает публичные части всех ключей связки
+func (r *KeyRing) JWKS() []JWK {
+	kids := make([]string, 0, len(r.keys))
+	for kid := range r.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	b64 := base64.RawURLEncoding
+	var out []JWK
+	for _, kid := range kids {
+		key := r.keys[kid]
+		jwk := JWK{Kid: kid, Alg: key.method.Alg(), Use: "sig"}
+		switch pub := key.private.Public().(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = b64.EncodeToString(pub.N.Bytes())
+			jwk.E = b64.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = b64.EncodeToString(pub)
+		}
+		out = append(out, jwk)
+	}
+	return out
+}
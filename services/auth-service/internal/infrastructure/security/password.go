@@ -1,18 +1,199 @@
 package security
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
 
-type PasswordHasher struct{}
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
 
-func NewPasswordHasher() *PasswordHasher {
-	return &PasswordHasher{}
+var ErrPasswordMismatch = errors.New("password does not match")
+
+// Hasher — один алгоритм хэширования паролей
+type Hasher interface {
+	Hash(password string) (string, error)
+	// Recognizes — хэш создан этим алгоритмом (по префиксу)
+	Recognizes(hash string) bool
+	Verify(hash, password string) error
+	// NeedsRehash — хэш создан с устаревшими параметрами
+	NeedsRehash(hash string) bool
+}
+
+// PasswordHasher хэширует основным алгоритмом и умеет проверять хэши старых алгоритмов,
+// чтобы пароли переезжали на новый алгоритм при входе, а не разом
+type PasswordHasher struct {
+	primary Hasher
+	legacy  []Hasher
+	// Хэш, с которым сравниваем пароль, если пользователя нет, —
+	// чтобы по времени ответа нельзя было узнать, зарегистрирован ли email
+	dummyHash string
+}
+
+func NewPasswordHasher(primary Hasher, legacy ...Hasher) *PasswordHasher {
+	dummy, _ := primary.Hash("dummy-password")
+	return &PasswordHasher{primary: primary, legacy: legacy, dummyHash: dummy}
 }
 
 func (h *PasswordHasher) Hash(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	return h.primary.Hash(password)
+}
+
+// Compare проверяет пароль. rehash=true — пароль верный, но хэш стоит пересчитать
+// основным алгоритмом с текущими параметрами.
+func (h *PasswordHasher) Compare(hash, password string) (rehash bool, err error) {
+	if h.primary.Recognizes(hash) {
+		if err := h.primary.Verify(hash, password); err != nil {
+			return false, err
+		}
+		return h.primary.NeedsRehash(hash), nil
+	}
+	for _, l := range h.legacy {
+		if l.Recognizes(hash) {
+			if err := l.Verify(hash, password); err != nil {
+				return false, err
+			}
+			return true, nil
+		}
+	}
+	return false, errors.New("unknown password hash format")
+}
+
+// CompareDummy тратит столько же времени, сколько Compare, и всегда возвращает ошибку
+func (h *PasswordHasher) CompareDummy(password string) error {
+	_ = h.primary.Verify(h.dummyHash, password)
+	return ErrPasswordMismatch
+}
+
+// Argon2Params — параметры argon2id (RFC 9106). Memory в КиБ.
+type Argon2Params struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// Argon2idHasher хранит хэш в формате PHC: $argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>
+type Argon2idHasher struct {
+	params Argon2Params
+}
+
+func NewArgon2idHasher(p Argon2Params) *Argon2idHasher {
+	if p.SaltLength == 0 {
+		p.SaltLength = 16
+	}
+	if p.KeyLength == 0 {
+		p.KeyLength = 32
+	}
+	return &Argon2idHasher{params: p}
+}
+
+func (h *Argon2idHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	p := h.params
+	key := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, p.KeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, p.Memory, p.Iterations, p.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func (h *Argon2idHasher) Recognizes(hash string) bool {
+	return strings.HasPrefix(hash, "$argon2id$")
+}
+
+func (h *Argon2idHasher) Verify(hash, password string) error {
+	p, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return err
+	}
+	other := argon2.IDKey([]byte(password), salt, p.Iterations, p.Memory, p.Parallelism, uint32(len(key)))
+	if subtle.ConstantTimeCompare(key, other) != 1 {
+		return ErrPasswordMismatch
+	}
+	return nil
+}
+
+func (h *Argon2idHasher) NeedsRehash(hash string) bool {
+	p, salt, key, err := decodeArgon2id(hash)
+	if err != nil {
+		return true
+	}
+	return p.Memory != h.params.Memory ||
+		p.Iterations != h.params.Iterations ||
+		p.Parallelism != h.params.Parallelism ||
+		uint32(len(salt)) != h.params.SaltLength ||
+		uint32(len(key)) != h.params.KeyLength
+}
+
+func decodeArgon2id(hash string) (Argon2Params, []byte, []byte, error) {
+	var p Argon2Params
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, hash
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return p, nil, nil, errors.New("invalid argon2id hash")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return p, nil, nil, errors.New("unsupported argon2 version")
+	}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.Memory, &p.Iterations, &p.Parallelism); err != nil {
+		return p, nil, nil, errors.New("invalid argon2id parameters")
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return p, nil, nil, err
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return p, nil, nil, err
+	}
+	p.SaltLength, p.KeyLength = uint32(len(salt)), uint32(len(key))
+	return p, salt, key, nil
+}
+
+// BcryptHasher — прежний алгоритм; нужен, чтобы проверять уже сохраненные хэши
+type BcryptHasher struct {
+	cost int
+}
+
+func NewBcryptHasher(cost int) *BcryptHasher {
+	if cost == 0 {
+		cost = bcrypt.DefaultCost
+	}
+	return &BcryptHasher{cost: cost}
+}
+
+func (h *BcryptHasher) Hash(password string) (string, error) {
+	bytes, err := bcrypt.GenerateFromPassword([]byte(password), h.cost)
 	return string(bytes), err
 }
 
-func (h *PasswordHasher) Compare(hash, password string) error {
-	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+func (h *BcryptHasher) Recognizes(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+func (h *BcryptHasher) Verify(hash, password string) error {
+	if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return ErrPasswordMismatch
+		}
+		return err
+	}
+	return nil
+}
+
+func (h *BcryptHasher) NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err != nil || cost != h.cost
 }
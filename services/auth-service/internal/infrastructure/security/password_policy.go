@@ -0,0 +1,96 @@
+package security
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"auth-service/internal/domain"
+)
+
+// PasswordPolicy — требования к новому паролю при регистрации и сбросе
+type PasswordPolicy struct {
+	minLength int
+	maxLength int
+	// SHA-1 (hex, верхний регистр) утекших паролей
+	breached map[string]struct{}
+}
+
+// NewPasswordPolicy загружает список утекших паролей. Каждая строка файла — либо сам пароль,
+// либо SHA-1 в формате Have I Been Pwned ("HASH" или "HASH:count"). Пустой путь — без списка.
+func NewPasswordPolicy(minLength, maxLength int, breachedFile string) (*PasswordPolicy, error) {
+	p := &PasswordPolicy{minLength: minLength, maxLength: maxLength, breached: map[string]struct{}{}}
+	if breachedFile == "" {
+		return p, nil
+	}
+
+	f, err := os.Open(breachedFile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if hash, ok := hibpHash(line); ok {
+			p.breached[hash] = struct{}{}
+			continue
+		}
+		p.breached[sha1Hex(line)] = struct{}{}
+	}
+	return p, scanner.Err()
+}
+
+// BreachedCount — сколько паролей в списке (для лога при старте)
+func (p *PasswordPolicy) BreachedCount() int {
+	return len(p.breached)
+}
+
+// Validate возвращает ошибку, оборачивающую domain.ErrWeakPassword, с причиной для пользователя
+func (p *PasswordPolicy) Validate(password, email, username string) error {
+	n := utf8.RuneCountInString(password)
+	if n < p.minLength {
+		return fmt.Errorf("%w: must be at least %d characters", domain.ErrWeakPassword, p.minLength)
+	}
+	if p.maxLength > 0 && n > p.maxLength {
+		return fmt.Errorf("%w: must be at most %d characters", domain.ErrWeakPassword, p.maxLength)
+	}
+
+	lower := strings.ToLower(password)
+	local := strings.ToLower(email)
+	if i := strings.IndexByte(local, '@'); i >= 0 {
+		local = local[:i]
+	}
+	if lower == strings.ToLower(email) || lower == local || lower == strings.ToLower(username) {
+		return fmt.Errorf("%w: must not match your email or username", domain.ErrWeakPassword)
+	}
+
+	if _, ok := p.breached[sha1Hex(password)]; ok {
+		return fmt.Errorf("%w: this password appeared in a data breach, choose another one", domain.ErrWeakPassword)
+	}
+	return nil
+}
+
+func hibpHash(line string) (string, bool) {
+	hash, _, _ := strings.Cut(line, ":")
+	if len(hash) != 40 {
+		return "", false
+	}
+	if _, err := hex.DecodeString(hash); err != nil {
+		return "", false
+	}
+	return strings.ToUpper(hash), true
+}
+
+func sha1Hex(s string) string {
+	sum := sha1.Sum([]byte(s))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
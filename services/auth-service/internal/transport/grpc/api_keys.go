@@ -0,0 +1,99 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"auth-service/internal/domain"
+	authpb "auth-service/pkg/authpb/proto/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AuthServer) CreateAPIKey(ctx context.Context, req *authpb.CreateAPIKeyRequest) (*authpb.CreateAPIKeyResponse, error) {
+	var expiresAt *time.Time
+	if req.ExpiresAt > 0 {
+		t := time.Unix(req.ExpiresAt, 0)
+		expiresAt = &t
+	}
+
+	key, apiKey, err := s.useCase.CreateAPIKey(ctx, req.UserId, req.Name, req.Scopes, expiresAt)
+	if err != nil {
+		return nil, apiKeyError(err)
+	}
+	return &authpb.CreateAPIKeyResponse{Key: key, ApiKey: apiKeyInfo(apiKey)}, nil
+}
+
+func (s *AuthServer) ListAPIKeys(ctx context.Context, req *authpb.ListAPIKeysRequest) (*authpb.ListAPIKeysResponse, error) {
+	keys, err := s.useCase.ListAPIKeys(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &authpb.ListAPIKeysResponse{ApiKeys: make([]*authpb.APIKeyInfo, 0, len(keys))}
+	for i := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, apiKeyInfo(&keys[i]))
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) RevokeAPIKey(ctx context.Context, req *authpb.RevokeAPIKeyRequest) (*authpb.RevokeAPIKeyResponse, error) {
+	if err := s.useCase.RevokeAPIKey(ctx, req.UserId, req.Id); err != nil {
+		return nil, apiKeyError(err)
+	}
+	return &authpb.RevokeAPIKeyResponse{Success: true}, nil
+}
+
+func (s *AuthServer) ValidateAPIKey(ctx context.Context, req *authpb.ValidateAPIKeyRequest) (*authpb.ValidateAPIKeyResponse, error) {
+	principal, err := s.useCase.ValidateAPIKey(ctx, req.Key, req.Ip)
+	if err != nil {
+		return nil, apiKeyError(err)
+	}
+	return &authpb.ValidateAPIKeyResponse{
+		UserId:      principal.UserID,
+		KeyId:       principal.KeyID,
+		Scopes:      principal.Scopes,
+		Roles:       principal.Roles,
+		Permissions: principal.Permissions,
+	}, nil
+}
+
+func apiKeyInfo(k *domain.APIKey) *authpb.APIKeyInfo {
+	info := &authpb.APIKeyInfo{
+		Id:         k.ID.String(),
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		LastUsedIp: k.LastUsedIP,
+		CreatedAt:  k.CreatedAt.Unix(),
+	}
+	if k.ExpiresAt != nil {
+		info.ExpiresAt = k.ExpiresAt.Unix()
+	}
+	if k.LastUsedAt != nil {
+		info.LastUsedAt = k.LastUsedAt.Unix()
+	}
+	return info
+}
+
+func apiKeyError(err error) error {
+	var banned *domain.BannedError
+	switch {
+	case errors.Is(err, domain.ErrInvalidAPIKey):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.As(err, &banned):
+		return status.Error(codes.PermissionDenied, banned.Error())
+	case errors.Is(err, domain.ErrAPIKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrAPIKeyName),
+		errors.Is(err, domain.ErrAPIKeyScope),
+		errors.Is(err, domain.ErrAPIKeyExpiresAt):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrTooManyAPIKeys):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
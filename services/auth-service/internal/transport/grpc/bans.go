@@ -0,0 +1,80 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"auth-service/internal/domain"
+	authpb "auth-service/pkg/authpb/proto/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AuthServer) BanUser(ctx context.Context, req *authpb.BanUserRequest) (*authpb.BanInfo, error) {
+	duration := time.Duration(req.DurationSeconds) * time.Second
+	ban, err := s.useCase.BanUser(ctx, req.ActorId, req.UserId, req.Reason, duration)
+	if err != nil {
+		return nil, banError(err)
+	}
+	return banInfo(ban), nil
+}
+
+func (s *AuthServer) UnbanUser(ctx context.Context, req *authpb.UnbanUserRequest) (*authpb.UnbanUserResponse, error) {
+	if err := s.useCase.UnbanUser(ctx, req.ActorId, req.UserId); err != nil {
+		return nil, banError(err)
+	}
+	return &authpb.UnbanUserResponse{Success: true}, nil
+}
+
+func (s *AuthServer) GetBans(ctx context.Context, req *authpb.GetBansRequest) (*authpb.GetBansResponse, error) {
+	bans, err := s.useCase.GetBans(ctx, req.UserId)
+	if err != nil {
+		return nil, banError(err)
+	}
+
+	res := &authpb.GetBansResponse{Bans: make([]*authpb.BanInfo, 0, len(bans))}
+	for i := range bans {
+		res.Bans = append(res.Bans, banInfo(&bans[i]))
+	}
+	return res, nil
+}
+
+func banInfo(b *domain.Ban) *authpb.BanInfo {
+	info := &authpb.BanInfo{
+		Id:        b.ID.String(),
+		UserId:    b.UserID.String(),
+		Reason:    b.Reason,
+		BannedBy:  b.BannedBy.String(),
+		CreatedAt: b.CreatedAt.Unix(),
+		Active:    b.Active(time.Now()),
+	}
+	if b.ExpiresAt != nil {
+		info.ExpiresAt = b.ExpiresAt.Unix()
+	}
+	if b.LiftedAt != nil {
+		info.LiftedAt = b.LiftedAt.Unix()
+	}
+	if b.LiftedBy != nil {
+		info.LiftedBy = b.LiftedBy.String()
+	}
+	return info
+}
+
+func banError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrBanReason),
+		errors.Is(err, domain.ErrBanDuration),
+		errors.Is(err, domain.ErrBanSelf):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrPermissionDenied), errors.Is(err, domain.ErrBanPrivileged):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrUserNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrNotBanned):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
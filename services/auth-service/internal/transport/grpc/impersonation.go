@@ -0,0 +1,63 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+
+	"auth-service/internal/domain"
+	authpb "auth-service/pkg/authpb/proto/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AuthServer) Impersonate(ctx context.Context, req *authpb.ImpersonateRequest) (*authpb.ImpersonateResponse, error) {
+	token, err := s.useCase.Impersonate(ctx, req.ActorId, req.UserId, req.Reason, req.Ip)
+	if err != nil {
+		return nil, impersonationError(err)
+	}
+	return &authpb.ImpersonateResponse{AccessToken: token.AccessToken, ExpiresAt: token.ExpiresAt.Unix()}, nil
+}
+
+func (s *AuthServer) RecordImpersonatedRequest(ctx context.Context, req *authpb.RecordImpersonatedRequestRequest) (*authpb.RecordImpersonatedRequestResponse, error) {
+	err := s.useCase.RecordImpersonatedRequest(ctx, req.ActorId, req.UserId, req.TokenId, req.Method, req.Path, req.Ip)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &authpb.RecordImpersonatedRequestResponse{}, nil
+}
+
+func (s *AuthServer) GetImpersonationAudit(ctx context.Context, req *authpb.GetImpersonationAuditRequest) (*authpb.GetImpersonationAuditResponse, error) {
+	entries, err := s.useCase.GetImpersonationAudit(ctx, req.UserId, int(req.Limit))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &authpb.GetImpersonationAuditResponse{Entries: make([]*authpb.ImpersonationAuditEntry, 0, len(entries))}
+	for _, e := range entries {
+		resp.Entries = append(resp.Entries, &authpb.ImpersonationAuditEntry{
+			CreatedAt: e.CreatedAt.Unix(),
+			ActorId:   e.ActorID.String(),
+			TokenId:   e.TokenID,
+			Event:     e.Event,
+			Reason:    e.Reason,
+			Method:    e.Method,
+			Path:      e.Path,
+			Ip:        e.IP,
+		})
+	}
+	return resp, nil
+}
+
+func impersonationError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrImpersonationReason), errors.Is(err, domain.ErrImpersonateSelf):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrPermissionDenied), errors.Is(err, domain.ErrImpersonatePrivileged):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrUserNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
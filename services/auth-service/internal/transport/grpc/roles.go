@@ -0,0 +1,51 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+
+	"auth-service/internal/domain"
+	authpb "auth-service/pkg/authpb/proto/auth"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AuthServer) GetRoles(ctx context.Context, req *authpb.GetRolesRequest) (*authpb.RolesResponse, error) {
+	roles, perms, err := s.useCase.GetRoles(ctx, req.UserId)
+	if err != nil {
+		return nil, roleError(err)
+	}
+	return &authpb.RolesResponse{Roles: roles, Permissions: perms}, nil
+}
+
+func (s *AuthServer) GrantRole(ctx context.Context, req *authpb.GrantRoleRequest) (*authpb.RolesResponse, error) {
+	roles, err := s.useCase.GrantRole(ctx, req.ActorId, req.UserId, req.Role)
+	if err != nil {
+		return nil, roleError(err)
+	}
+	return &authpb.RolesResponse{Roles: roles, Permissions: domain.Permissions(roles)}, nil
+}
+
+func (s *AuthServer) RevokeRole(ctx context.Context, req *authpb.RevokeRoleRequest) (*authpb.RolesResponse, error) {
+	roles, err := s.useCase.RevokeRole(ctx, req.ActorId, req.UserId, req.Role)
+	if err != nil {
+		return nil, roleError(err)
+	}
+	return &authpb.RolesResponse{Roles: roles, Permissions: domain.Permissions(roles)}, nil
+}
+
+func roleError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrUnknownRole):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrUserNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrLastAdmin):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
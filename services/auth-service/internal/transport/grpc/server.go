@@ -2,8 +2,12 @@ package grpc_server
 
 import (
 	"context"
+	"errors"
+	"log"
 
 	"auth-service/internal/application/usecase"
+	"auth-service/internal/domain"
+	"auth-service/internal/infrastructure/oauth"
 
 	authpb "auth-service/pkg/authpb/proto/auth"
 
@@ -22,30 +26,107 @@ func NewAuthServer(uc *usecase.AuthUseCase) *AuthServer {
 
 func (s *AuthServer) Register(ctx context.Context, req *authpb.RegisterRequest) (*authpb.RegisterResponse, error) {
 	userID, err := s.useCase.Register(ctx, req.Username, req.Email, req.Password)
+	if errors.Is(err, domain.ErrWeakPassword) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 	return &authpb.RegisterResponse{UserId: userID}, nil
 }
 
+func (s *AuthServer) VerifyEmail(ctx context.Context, req *authpb.VerifyEmailRequest) (*authpb.VerifyEmailResponse, error) {
+	err := s.useCase.VerifyEmail(ctx, req.Token)
+	if errors.Is(err, domain.ErrInvalidVerifyToken) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &authpb.VerifyEmailResponse{Success: true}, nil
+}
+
+func (s *AuthServer) ResendVerificationEmail(ctx context.Context, req *authpb.ResendVerificationEmailRequest) (*authpb.ResendVerificationEmailResponse, error) {
+	err := s.useCase.ResendVerification(ctx, req.UserId)
+	if errors.Is(err, domain.ErrEmailAlreadyVerified) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &authpb.ResendVerificationEmailResponse{Success: true}, nil
+}
+
 func (s *AuthServer) Login(ctx context.Context, req *authpb.LoginRequest) (*authpb.LoginResponse, error) {
-	access, refresh, err := s.useCase.Login(ctx, req.Email, req.Password, req.DeviceId, req.DeviceName)
+	res, err := s.useCase.Login(ctx, req.Email, req.Password, domain.LoginAttempt{
+		DeviceID:   req.DeviceId,
+		DeviceName: req.DeviceName,
+		IP:         req.Ip,
+		UserAgent:  req.UserAgent,
+	})
+	if err != nil {
+		return nil, loginError(err)
+	}
+	return loginResponse(res), nil
+}
+
+func (s *AuthServer) UnlockAccount(ctx context.Context, req *authpb.UnlockAccountRequest) (*authpb.UnlockAccountResponse, error) {
+	err := s.useCase.UnlockAccount(ctx, req.Token)
+	if errors.Is(err, domain.ErrInvalidUnlockToken) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	return &authpb.LoginResponse{AccessToken: access, RefreshToken: refresh}, nil
+	return &authpb.UnlockAccountResponse{Success: true}, nil
+}
+
+func (s *AuthServer) VerifyTwoFactor(ctx context.Context, req *authpb.VerifyTwoFactorRequest) (*authpb.LoginResponse, error) {
+	res, err := s.useCase.VerifyTwoFactor(ctx, req.ChallengeToken, req.Code)
+	if err != nil {
+		return nil, twoFactorError(err)
+	}
+	return loginResponse(res), nil
 }
 
 func (s *AuthServer) Validate(ctx context.Context, req *authpb.ValidateRequest) (*authpb.ValidateResponse, error) {
-	userID, err := s.useCase.ValidateAccess(req.AccessToken)
+	claims, err := s.useCase.ValidateAccess(ctx, req.AccessToken)
 	if err != nil {
 		return &authpb.ValidateResponse{}, status.Error(codes.Unauthenticated, "invalid token")
 	}
-	return &authpb.ValidateResponse{UserId: userID}, nil
+	return &authpb.ValidateResponse{
+		UserId:      claims.UserID,
+		DeviceId:    claims.DeviceID,
+		Mfa:         claims.MFA,
+		Roles:       claims.Roles,
+		Permissions: claims.Permissions,
+		ActorId:     claims.ActorID,
+	}, nil
+}
+
+func (s *AuthServer) GetJWKS(ctx context.Context, req *authpb.GetJWKSRequest) (*authpb.GetJWKSResponse, error) {
+	var keys []*authpb.JWK
+	for _, k := range s.useCase.JWKS() {
+		keys = append(keys, &authpb.JWK{
+			Kid: k.Kid,
+			Kty: k.Kty,
+			Alg: k.Alg,
+			Use: k.Use,
+			N:   k.N,
+			E:   k.E,
+			Crv: k.Crv,
+			X:   k.X,
+		})
+	}
+	return &authpb.GetJWKSResponse{Keys: keys}, nil
 }
 
 func (s *AuthServer) RefreshToken(ctx context.Context, req *authpb.RefreshTokenRequest) (*authpb.RefreshTokenResponse, error) {
 	access, refresh, err := s.useCase.Refresh(ctx, req.RefreshToken)
+	var banned *domain.BannedError
+	if errors.As(err, &banned) {
+		return nil, status.Error(codes.PermissionDenied, banned.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "failed to refresh")
 	}
@@ -53,7 +134,7 @@ func (s *AuthServer) RefreshToken(ctx context.Context, req *authpb.RefreshTokenR
 }
 
 func (s *AuthServer) Logout(ctx context.Context, req *authpb.LogoutRequest) (*authpb.LogoutResponse, error) {
-	_ = s.useCase.Logout(ctx, req.RefreshToken, req.DeviceId)
+	_ = s.useCase.Logout(ctx, req.RefreshToken, req.AccessToken, req.DeviceId)
 	return &authpb.LogoutResponse{Success: true}, nil
 }
 
@@ -65,16 +146,45 @@ func (s *AuthServer) ForgotPassword(ctx context.Context, req *authpb.ForgotPassw
 	return &authpb.ForgotPasswordResponse{Success: true}, nil
 }
 
+func (s *AuthServer) RequestMagicLink(ctx context.Context, req *authpb.RequestMagicLinkRequest) (*authpb.RequestMagicLinkResponse, error) {
+	if err := s.useCase.RequestMagicLink(ctx, req.Email); err != nil {
+		return nil, status.Error(codes.Internal, "failed to process request")
+	}
+	return &authpb.RequestMagicLinkResponse{Success: true}, nil
+}
+
+func (s *AuthServer) MagicLinkLogin(ctx context.Context, req *authpb.MagicLinkLoginRequest) (*authpb.LoginResponse, error) {
+	res, err := s.useCase.LoginWithMagicLink(ctx, req.Token, domain.LoginAttempt{
+		DeviceID:   req.DeviceId,
+		DeviceName: req.DeviceName,
+		IP:         req.Ip,
+		UserAgent:  req.UserAgent,
+	})
+	if errors.Is(err, domain.ErrInvalidMagicLink) {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err != nil {
+		return nil, loginError(err)
+	}
+	return loginResponse(res), nil
+}
+
 func (s *AuthServer) ResetPassword(ctx context.Context, req *authpb.ResetPasswordRequest) (*authpb.ResetPasswordResponse, error) {
 	err := s.useCase.ResetPassword(ctx, req.Token, req.NewPassword)
-	if err != nil {
+	if errors.Is(err, domain.ErrWeakPassword) {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
 	return &authpb.ResetPasswordResponse{Success: true}, nil
 }
 
 func (s *AuthServer) RequestEmailChange(ctx context.Context, req *authpb.RequestEmailChangeRequest) (*authpb.RequestEmailChangeResponse, error) {
-	err := s.useCase.RequestEmailChange(ctx, req.UserId, req.NewEmail)
+	err := s.useCase.RequestEmailChange(ctx, req.UserId, req.NewEmail, req.TwoFactorCode)
+	if errors.Is(err, domain.ErrInvalidTwoFactorCode) || errors.Is(err, domain.ErrTooManyTwoFactorAttempts) {
+		return nil, twoFactorError(err)
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -95,16 +205,20 @@ func (s *AuthServer) GetDevices(ctx context.Context, req *authpb.GetDevicesReque
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	return &authpb.GetDevicesResponse{Devices: deviceInfos(devices, req.CurrentDeviceId)}, nil
+}
+
+func deviceInfos(devices []domain.Device, currentDeviceID string) []*authpb.DeviceInfo {
 	var pbDevices []*authpb.DeviceInfo
 	for _, d := range devices {
 		pbDevices = append(pbDevices, &authpb.DeviceInfo{
 			Id:         d.DeviceID,
 			DeviceName: d.DeviceName,
 			LastActive: d.LastActiveAt.String(),
+			IsCurrent:  currentDeviceID != "" && d.DeviceID == currentDeviceID,
 		})
 	}
-
-	return &authpb.GetDevicesResponse{Devices: pbDevices}, nil
+	return pbDevices
 }
 
 func (s *AuthServer) RemoveDevice(ctx context.Context, req *authpb.RemoveDeviceRequest) (*authpb.RemoveDeviceResponse, error) {
@@ -114,3 +228,284 @@ func (s *AuthServer) RemoveDevice(ctx context.Context, req *authpb.RemoveDeviceR
 	}
 	return &authpb.RemoveDeviceResponse{Success: true}, nil
 }
+
+func (s *AuthServer) ReplaceDevice(ctx context.Context, req *authpb.ReplaceDeviceRequest) (*authpb.LoginResponse, error) {
+	res, err := s.useCase.ReplaceDevice(ctx, req.ChallengeToken, req.DeviceId)
+	if err != nil {
+		return nil, loginError(err)
+	}
+	return loginResponse(res), nil
+}
+
+func (s *AuthServer) ReconcileDevices(ctx context.Context, req *authpb.ReconcileDevicesRequest) (*authpb.ReconcileDevicesResponse, error) {
+	removed, err := s.useCase.ReconcileDevices(ctx, req.UserId, int(req.DeviceLimit))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &authpb.ReconcileDevicesResponse{Removed: int32(removed)}, nil
+}
+
+func (s *AuthServer) RevokeAllSessions(ctx context.Context, req *authpb.RevokeAllSessionsRequest) (*authpb.RevokeAllSessionsResponse, error) {
+	err := s.useCase.RevokeAllSessions(ctx, req.UserId, req.ExceptDeviceId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &authpb.RevokeAllSessionsResponse{Success: true}, nil
+}
+
+func (s *AuthServer) SetupTwoFactor(ctx context.Context, req *authpb.SetupTwoFactorRequest) (*authpb.SetupTwoFactorResponse, error) {
+	secret, uri, err := s.useCase.SetupTwoFactor(ctx, req.UserId)
+	if err != nil {
+		return nil, twoFactorError(err)
+	}
+	return &authpb.SetupTwoFactorResponse{Secret: secret, OtpauthUri: uri}, nil
+}
+
+func (s *AuthServer) EnableTwoFactor(ctx context.Context, req *authpb.EnableTwoFactorRequest) (*authpb.RecoveryCodesResponse, error) {
+	recoveryCodes, err := s.useCase.EnableTwoFactor(ctx, req.UserId, req.Code)
+	if err != nil {
+		return nil, twoFactorError(err)
+	}
+	return &authpb.RecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *AuthServer) DisableTwoFactor(ctx context.Context, req *authpb.DisableTwoFactorRequest) (*authpb.DisableTwoFactorResponse, error) {
+	if err := s.useCase.DisableTwoFactor(ctx, req.UserId, req.Code); err != nil {
+		return nil, twoFactorError(err)
+	}
+	return &authpb.DisableTwoFactorResponse{Success: true}, nil
+}
+
+func (s *AuthServer) RegenerateRecoveryCodes(ctx context.Context, req *authpb.RegenerateRecoveryCodesRequest) (*authpb.RecoveryCodesResponse, error) {
+	recoveryCodes, err := s.useCase.RegenerateRecoveryCodes(ctx, req.UserId, req.Code)
+	if err != nil {
+		return nil, twoFactorError(err)
+	}
+	return &authpb.RecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *AuthServer) GetTwoFactorStatus(ctx context.Context, req *authpb.GetTwoFactorStatusRequest) (*authpb.GetTwoFactorStatusResponse, error) {
+	enabled, left, err := s.useCase.GetTwoFactorStatus(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &authpb.GetTwoFactorStatusResponse{Enabled: enabled, RecoveryCodesLeft: int32(left)}, nil
+}
+
+// StartOAuth отдает ссылку на провайдера; с user_id — для привязки к уже вошедшему аккаунту
+func (s *AuthServer) StartOAuth(ctx context.Context, req *authpb.StartOAuthRequest) (*authpb.StartOAuthResponse, error) {
+	url, err := s.useCase.StartOAuth(ctx, req.Provider, req.DeviceId, req.DeviceName, req.UserId)
+	if err != nil {
+		return nil, oauthError(err)
+	}
+	return &authpb.StartOAuthResponse{Url: url}, nil
+}
+
+func (s *AuthServer) CompleteOAuth(ctx context.Context, req *authpb.CompleteOAuthRequest) (*authpb.CompleteOAuthResponse, error) {
+	res, err := s.useCase.CompleteOAuth(ctx, req.Provider, req.State, req.Code, req.DeviceId, req.Ip, req.UserAgent)
+	if err != nil {
+		return nil, oauthError(err)
+	}
+	if res.Linked {
+		return &authpb.CompleteOAuthResponse{Linked: true}, nil
+	}
+	return &authpb.CompleteOAuthResponse{Login: loginResponse(res.Login)}, nil
+}
+
+func (s *AuthServer) TelegramLogin(ctx context.Context, req *authpb.TelegramLoginRequest) (*authpb.LoginResponse, error) {
+	res, err := s.useCase.LoginWithTelegram(ctx, req.AuthData, domain.LoginAttempt{
+		DeviceID:   req.DeviceId,
+		DeviceName: req.DeviceName,
+		IP:         req.Ip,
+		UserAgent:  req.UserAgent,
+	})
+	if err != nil {
+		return nil, oauthError(err)
+	}
+	return loginResponse(res), nil
+}
+
+func (s *AuthServer) LinkTelegram(ctx context.Context, req *authpb.LinkTelegramRequest) (*authpb.LinkTelegramResponse, error) {
+	if err := s.useCase.LinkTelegram(ctx, req.UserId, req.AuthData); err != nil {
+		return nil, oauthError(err)
+	}
+	return &authpb.LinkTelegramResponse{Success: true}, nil
+}
+
+func (s *AuthServer) ListIdentities(ctx context.Context, req *authpb.ListIdentitiesRequest) (*authpb.ListIdentitiesResponse, error) {
+	identities, err := s.useCase.ListIdentities(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &authpb.ListIdentitiesResponse{}
+	for _, i := range identities {
+		res.Identities = append(res.Identities, &authpb.Identity{
+			Provider: i.Provider,
+			Email:    i.Email,
+			LinkedAt: i.CreatedAt.Unix(),
+		})
+	}
+	return res, nil
+}
+
+func (s *AuthServer) UnlinkIdentity(ctx context.Context, req *authpb.UnlinkIdentityRequest) (*authpb.UnlinkIdentityResponse, error) {
+	if err := s.useCase.UnlinkIdentity(ctx, req.UserId, req.Provider); err != nil {
+		return nil, oauthError(err)
+	}
+	return &authpb.UnlinkIdentityResponse{Success: true}, nil
+}
+
+func (s *AuthServer) DeleteAccount(ctx context.Context, req *authpb.DeleteAccountRequest) (*authpb.DeleteAccountResponse, error) {
+	res, err := s.useCase.DeleteAccount(ctx, req.UserId, req.Password, req.TwoFactorCode)
+	if err != nil {
+		return nil, deletionError(err)
+	}
+	resp := &authpb.DeleteAccountResponse{ConfirmationSent: res.ConfirmationSent}
+	if !res.DeleteAfter.IsZero() {
+		resp.DeleteAfter = res.DeleteAfter.Unix()
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) ConfirmAccountDeletion(ctx context.Context, req *authpb.ConfirmAccountDeletionRequest) (*authpb.DeleteAccountResponse, error) {
+	deleteAfter, err := s.useCase.ConfirmAccountDeletion(ctx, req.Token)
+	if err != nil {
+		return nil, deletionError(err)
+	}
+	return &authpb.DeleteAccountResponse{DeleteAfter: deleteAfter.Unix()}, nil
+}
+
+func (s *AuthServer) ExportMyData(ctx context.Context, req *authpb.ExportMyDataRequest) (*authpb.ExportMyDataResponse, error) {
+	data, err := s.useCase.ExportMyData(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, "failed to export data: "+err.Error())
+	}
+	return &authpb.ExportMyDataResponse{Data: data}, nil
+}
+
+func (s *AuthServer) GetLoginHistory(ctx context.Context, req *authpb.GetLoginHistoryRequest) (*authpb.GetLoginHistoryResponse, error) {
+	events, err := s.useCase.GetLoginHistory(ctx, req.UserId, int(req.Limit))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &authpb.GetLoginHistoryResponse{Events: make([]*authpb.LoginEvent, 0, len(events))}
+	for _, e := range events {
+		resp.Events = append(resp.Events, &authpb.LoginEvent{
+			CreatedAt:     e.CreatedAt.Unix(),
+			Method:        e.Method,
+			Success:       e.Success,
+			FailureReason: e.FailureReason,
+			Ip:            e.IP,
+			UserAgent:     e.UserAgent,
+			DeviceId:      e.DeviceID,
+			DeviceName:    e.DeviceName,
+			NewDevice:     e.NewDevice,
+		})
+	}
+	return resp, nil
+}
+
+func (s *AuthServer) RevokeDeviceByToken(ctx context.Context, req *authpb.RevokeDeviceByTokenRequest) (*authpb.RevokeDeviceByTokenResponse, error) {
+	err := s.useCase.RevokeDeviceByToken(ctx, req.Token)
+	if errors.Is(err, domain.ErrInvalidDeviceRevokeToken) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &authpb.RevokeDeviceByTokenResponse{Success: true}, nil
+}
+
+func loginResponse(res *usecase.LoginResult) *authpb.LoginResponse {
+	return &authpb.LoginResponse{
+		AccessToken:            res.AccessToken,
+		RefreshToken:           res.RefreshToken,
+		TwoFactorRequired:      res.ChallengeToken != "",
+		ChallengeToken:         res.ChallengeToken,
+		TwoFactorSetupRequired: res.TwoFactorSetupRequired,
+		// Пользователь еще не вошел — текущего устройства среди них нет
+		DeviceConfirmationRequired: res.DeviceChallengeToken != "",
+		DeviceChallengeToken:       res.DeviceChallengeToken,
+		Devices:                    deviceInfos(res.Devices, ""),
+	}
+}
+
+// loginError: неверный email и неверный пароль неразличимы, перебор — ResourceExhausted
+func loginError(err error) error {
+	var throttled *domain.LoginThrottledError
+	var limitErr *domain.DeviceLimitError
+	var banned *domain.BannedError
+	switch {
+	case errors.Is(err, domain.ErrInvalidCredentials):
+		return status.Error(codes.Unauthenticated, "invalid credentials")
+	case errors.As(err, &throttled):
+		return status.Error(codes.ResourceExhausted, throttled.Error())
+	case errors.As(err, &limitErr):
+		return status.Error(codes.ResourceExhausted, limitErr.Error())
+	case errors.Is(err, domain.ErrDeviceChallengeExpired):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.As(err, &banned):
+		return status.Error(codes.PermissionDenied, banned.Error())
+	case errors.Is(err, domain.ErrAccountDeleted):
+		return status.Error(codes.PermissionDenied, err.Error())
+	default:
+		// Ошибки БД и Redis клиенту не показываем
+		log.Printf("Login failed: %v", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+}
+
+// twoFactorError переводит ошибки 2FA в коды gRPC, чтобы Gateway отдал понятный HTTP-статус
+func twoFactorError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidTwoFactorCode), errors.Is(err, domain.ErrChallengeExpired):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, domain.ErrTwoFactorAlreadyEnabled),
+		errors.Is(err, domain.ErrTwoFactorNotEnabled),
+		errors.Is(err, domain.ErrTwoFactorSetupExpired):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrTooManyTwoFactorAttempts):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		// Второй шаг входа завершается как Login: лимит устройств, бан
+		return loginError(err)
+	}
+}
+
+// deletionError: неверный пароль или код — Unauthenticated, повторный запрос — FailedPrecondition
+func deletionError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidCredentials):
+		return status.Error(codes.Unauthenticated, "invalid password")
+	case errors.Is(err, domain.ErrInvalidTwoFactorCode), errors.Is(err, domain.ErrTooManyTwoFactorAttempts):
+		return twoFactorError(err)
+	case errors.Is(err, domain.ErrInvalidDeletionToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrDeletionScheduled):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+// oauthError: ошибки провайдера и state — Unauthenticated, конфликты аккаунтов — FailedPrecondition
+func oauthError(err error) error {
+	switch {
+	case errors.Is(err, oauth.ErrUnknownProvider):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidOAuthState), errors.Is(err, domain.ErrOAuthFailed):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, domain.ErrIdentityNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrOAuthEmailRequired),
+		errors.Is(err, domain.ErrOAuthEmailConflict),
+		errors.Is(err, domain.ErrIdentityAlreadyLinked),
+		errors.Is(err, domain.ErrProviderAlreadyLinked),
+		errors.Is(err, domain.ErrLastLoginMethod):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return loginError(err)
+	}
+}
@@ -105,16 +105,17 @@ func (s *CourseServer) GetCourse(ctx context.Context, req *coursepb.GetCourseReq
 
 			// 2. Если курс не начат, проверяем подписку
 			if !hasAccess {
-				isAdmin := statusSub == "admin"
+				// Сотрудники с правом content:all (Gateway берет его из токена) смотрят все курсы
+				fullAccess := req.FullAccess
 				// Проверяем дату истечения (сравниваем Unix timestamp)
 				isSubscriptionActive := expiresAt > time.Now().Unix()
 
-				if isAdmin || isSubscriptionActive {
+				if fullAccess || isSubscriptionActive {
 					hasAccess = true
 					log.Printf("[CourseService DEBUG] Access GRANTED via subscription")
 				} else {
-					log.Printf("[CourseService DEBUG] Access DENIED. Admin: %v, Active: %v (Exp: %d vs Now: %d)",
-						isAdmin, isSubscriptionActive, expiresAt, time.Now().Unix())
+					log.Printf("[CourseService DEBUG] Access DENIED. Full access: %v, Active: %v (Exp: %d vs Now: %d)",
+						fullAccess, isSubscriptionActive, expiresAt, time.Now().Unix())
 				}
 			}
 		}
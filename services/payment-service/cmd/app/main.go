@@ -1,14 +1,17 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"net"
+	"time"
 
 	"payment-service/config"
 	"payment-service/internal/domain"
 	"payment-service/internal/repository"
 	grpc_server "payment-service/internal/transport/grpc"
+	coursepb "payment-service/pkg/coursepb/proto/course"
 	paymentpb "payment-service/pkg/paymentpb/proto/payment"
 
 	userpb "github.com/waste3d/gameplatform-api/services/user-service/pkg/userpb/proto/user"
@@ -34,7 +37,8 @@ func main() {
 	}
 
 	// Миграция
-	db.AutoMigrate(&domain.Plan{}, &domain.PromoCode{}, &domain.PromoActivation{})
+	db.AutoMigrate(&domain.Plan{}, &domain.PromoCode{}, &domain.PromoActivation{}, &domain.IdempotencyRecord{}, &domain.Order{}, &domain.CatalogItem{},
+		&domain.WheelPrize{}, &domain.WheelSpin{})
 
 	// Подключение к User Service
 	userConn, err := grpc.NewClient(cfg.UserSvcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -43,8 +47,22 @@ func main() {
 	}
 	userClient := userpb.NewUserServiceClient(userConn)
 
+	courseConn, err := grpc.NewClient(cfg.CourseSvcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatal("Failed to connect to Course Service:", err)
+	}
+	courseClient := coursepb.NewCourseServiceClient(courseConn)
+
 	repo := repository.NewPaymentRepository(db)
-	srv := grpc_server.NewPaymentServer(repo, userClient)
+	if err := repo.EnsureCatalogDefaults(context.Background(), domain.DefaultCatalog); err != nil {
+		log.Fatal("Failed to seed shop catalog:", err)
+	}
+	if err := repo.EnsureWheelPrizes(context.Background(), domain.DefaultWheelPrizes); err != nil {
+		log.Fatal("Failed to seed wheel prizes:", err)
+	}
+	srv := grpc_server.NewPaymentServer(repo, userClient, courseClient, cfg.IdempotencyTTL, cfg.WheelSpinCost)
+	go srv.RunIdempotencyCleanup(context.Background(), time.Hour)
+	go srv.RunOrderRecovery(context.Background(), time.Minute)
 
 	lis, err := net.Listen("tcp", cfg.GRPCPort)
 	if err != nil {
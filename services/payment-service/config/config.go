@@ -1,6 +1,10 @@
 package config
 
-import "github.com/spf13/viper"
+import (
+	"time"
+
+	"github.com/spf13/viper"
+)
 
 type Config struct {
 	DBHost     string `mapstructure:"DB_HOST"`
@@ -10,6 +14,13 @@ type Config struct {
 	DBName     string `mapstructure:"DB_NAME"`
 	GRPCPort   string `mapstructure:"GRPC_PORT"`
 	UserSvcUrl string `mapstructure:"USER_SVC_URL"`
+	// Course Service — название и обложка курса при покупке
+	CourseSvcUrl string `mapstructure:"COURSE_SVC_URL"`
+
+	// Сколько хранится ответ на запрос с Idempotency-Key
+	IdempotencyTTL time.Duration `mapstructure:"IDEMPOTENCY_TTL"`
+	// Цена вращения колеса в снежинках; первое вращение за день бесплатное
+	WheelSpinCost int `mapstructure:"WHEEL_SPIN_COST"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -18,6 +29,9 @@ func LoadConfig(path string) (config Config, err error) {
 	viper.SetConfigType("env")
 	viper.AutomaticEnv()
 
+	viper.SetDefault("IDEMPOTENCY_TTL", "24h")
+	viper.SetDefault("WHEEL_SPIN_COST", 100)
+
 	viper.BindEnv("DB_HOST")
 	viper.BindEnv("DB_PORT")
 	viper.BindEnv("DB_USER")
@@ -25,6 +39,9 @@ func LoadConfig(path string) (config Config, err error) {
 	viper.BindEnv("DB_NAME")
 	viper.BindEnv("GRPC_PORT")
 	viper.BindEnv("USER_SVC_URL")
+	viper.BindEnv("COURSE_SVC_URL")
+	viper.BindEnv("IDEMPOTENCY_TTL")
+	viper.BindEnv("WHEEL_SPIN_COST")
 
 	err = viper.ReadInConfig()
 	if err != nil {
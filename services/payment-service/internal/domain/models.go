@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
@@ -41,8 +42,132 @@ type PromoCode struct {
 	ExpiresAt        *time.Time // Дата сгорания кода (может быть null)
 }
 
+// Использования промокода кончились, пока шла активация
+var ErrPromoExhausted = errors.New("promo code usage limit reached")
+
 type PromoActivation struct {
 	UserID    string `gorm:"primaryKey;index"` // ID пользователя
 	Code      string `gorm:"primaryKey;index"` // Сам код (например "START3")
 	CreatedAt time.Time
 }
+
+// Операции, которые можно повторять с ключом идемпотентности
+const (
+	OperationPurchaseItem = "purchase_item"
+	OperationRedeemPromo  = "redeem_promo"
+	OperationSpinWheel    = "spin_wheel"
+)
+
+// IdempotencyRecord — ответ первого вызова операции с ключом идемпотентности.
+// Пока операция выполняется, Completed = false и повторы с тем же ключом отклоняются.
+type IdempotencyRecord struct {
+	UserID      string `gorm:"primaryKey"`
+	Key         string `gorm:"primaryKey"`
+	Operation   string
+	RequestHash string // Отпечаток параметров: тот же ключ с другими параметрами — ошибка клиента
+	Completed   bool
+	Response    []byte // Ответ в protobuf
+	CreatedAt   time.Time
+	ExpiresAt   time.Time `gorm:"index"`
+}
+
+// Статусы заказа. Списание и возврат проводятся с ключами по ID заказа,
+// поэтому любой шаг можно безопасно повторить после сбоя.
+const (
+	OrderPending  = "pending"  // Создан, снежинки еще не списаны
+	OrderDebited  = "debited"  // Списали, товар еще не выдан
+	OrderGranted  = "granted"  // Товар выдан
+	OrderRefunded = "refunded" // Выдать не удалось, снежинки вернули
+	OrderFailed   = "failed"   // Списание отвергнуто (например, не хватило снежинок) или вернуть их некуда
+)
+
+// Order — покупка в магазине за снежинки
+type Order struct {
+	ID     uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID string    `gorm:"index;uniqueIndex:idx_orders_idempotency,priority:1"`
+
+	ItemType string // COURSE, AVATAR
+	ItemID   string
+	// Нужны, чтобы воркер мог выдать курс без исходного запроса
+	CourseTitle    string
+	CourseCoverURL string
+	Price          int
+
+	Status string `gorm:"index"`
+	// Выдача отвергнута, заказ ждет возврата снежинок
+	Compensating bool
+	LastError    string
+
+	// Idempotency-Key запроса: повтор после сбоя продолжает тот же заказ
+	IdempotencyKey *string `gorm:"uniqueIndex:idx_orders_idempotency,priority:2"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Типы товаров магазина
+const (
+	ItemCourse = "COURSE"
+	ItemAvatar = "AVATAR"
+)
+
+// CatalogItem — товар магазина и его цена. Строка с пустым ItemID задает цену
+// для всех товаров этого типа без собственной строки (например, любого курса).
+type CatalogItem struct {
+	ItemType string `gorm:"primaryKey"`
+	ItemID   string `gorm:"primaryKey"`
+	// Для курсов название и обложку берем из Course Service
+	Title    string
+	CoverURL string
+
+	SnowflakePrice int
+	RubPrice       int
+	// Скидка в процентах; действует до DiscountUntil (nil — бессрочно)
+	DiscountPercent int
+	DiscountUntil   *time.Time
+
+	// Окно продажи; nil — без ограничения
+	AvailableFrom  *time.Time
+	AvailableUntil *time.Time
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Цены по умолчанию, которые записываются в пустой каталог при старте
+var DefaultCatalog = []CatalogItem{
+	{ItemType: ItemCourse, SnowflakePrice: 1000},
+	{ItemType: ItemAvatar, SnowflakePrice: 250},
+}
+
+func (i *CatalogItem) Available(now time.Time) bool {
+	if i.AvailableFrom != nil && now.Before(*i.AvailableFrom) {
+		return false
+	}
+	return i.AvailableUntil == nil || now.Before(*i.AvailableUntil)
+}
+
+// Discount — действующая скидка в процентах
+func (i *CatalogItem) Discount(now time.Time) int {
+	if i.DiscountPercent <= 0 || (i.DiscountUntil != nil && !now.Before(*i.DiscountUntil)) {
+		return 0
+	}
+	if i.DiscountPercent > 100 {
+		return 100
+	}
+	return i.DiscountPercent
+}
+
+// PriceAt — цена в снежинках с учетом скидки
+func (i *CatalogItem) PriceAt(now time.Time) int {
+	return discounted(i.SnowflakePrice, i.Discount(now))
+}
+
+// RubPriceAt — цена в рублях с учетом скидки
+func (i *CatalogItem) RubPriceAt(now time.Time) int {
+	return discounted(i.RubPrice, i.Discount(now))
+}
+
+func discounted(price, percent int) int {
+	return price * (100 - percent) / 100
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Призы колеса фортуны
+const (
+	PrizeBalance = "BALANCE" // Снежинки, Value — сколько
+	PrizeSlot    = "SLOT"    // Слоты для курсов, Value — сколько
+	PrizeAvatar  = "AVATAR"  // Аватарка, Value — ее ID
+	PrizeEmpty   = "EMPTY"
+)
+
+// Статусы вращения. Как и у заказа, списание, выдача приза и возврат идут с ключами
+// по ID вращения, поэтому шаг можно повторить после сбоя.
+const (
+	SpinPending  = "pending"  // Приз выбран, стоимость еще не списана
+	SpinDebited  = "debited"  // Списали (или бесплатное), приз еще не выдан
+	SpinGranted  = "granted"  // Приз выдан
+	SpinRefunded = "refunded" // Выдача отвергнута, стоимость вернули, бесплатный день освободили
+	SpinFailed   = "failed"   // Списание отвергнуто (например, не хватило снежинок) или вернуть их некуда
+)
+
+var ErrNoPrizes = errors.New("wheel prize table is empty")
+
+// WheelPrize — сектор колеса. Шанс выпадения — Weight / сумма весов всех секторов
+type WheelPrize struct {
+	ID     uint `gorm:"primaryKey"`
+	Type   string
+	Value  int
+	Weight int
+	Label  string
+	// Кто последним менял таблицу призов
+	UpdatedBy string
+	CreatedAt time.Time
+}
+
+// Призы по умолчанию, если таблица пуста
+var DefaultWheelPrizes = []WheelPrize{
+	{Type: PrizeEmpty, Weight: 50},
+	{Type: PrizeBalance, Value: 50, Weight: 30},
+	{Type: PrizeBalance, Value: 200, Weight: 12},
+	{Type: PrizeSlot, Value: 1, Weight: 8},
+}
+
+// WheelSpin — одно вращение колеса с результатом
+type WheelSpin struct {
+	ID     uuid.UUID `gorm:"type:uuid;primaryKey"`
+	UserID string    `gorm:"index;uniqueIndex:idx_wheel_free_day,priority:1;uniqueIndex:idx_wheel_idempotency,priority:1"`
+	Free   bool
+	// День бесплатного вращения (UTC); у платных и неудачных — nil. Не дает получить два бесплатных за день
+	FreeDay *string `gorm:"uniqueIndex:idx_wheel_free_day,priority:2"`
+	Cost    int
+
+	PrizeID    uint
+	PrizeType  string
+	PrizeValue int
+
+	Status string `gorm:"index"`
+	// Выдача отвергнута, вращение ждет возврата стоимости
+	Compensating bool
+	Error        string
+
+	// Idempotency-Key запроса: повтор после сбоя продолжает то же вращение
+	IdempotencyKey *string `gorm:"uniqueIndex:idx_wheel_idempotency,priority:2"`
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// PickPrize выбирает приз с учетом весов. Случайность — из crypto/rand,
+// чтобы исход нельзя было предсказать по предыдущим вращениям. Сектор с весом <= 0 не выпадает
+func PickPrize(prizes []WheelPrize) (*WheelPrize, error) {
+	total := 0
+	for _, p := range prizes {
+		if p.Weight > 0 {
+			total += p.Weight
+		}
+	}
+	if total <= 0 {
+		return nil, ErrNoPrizes
+	}
+
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(total)))
+	if err != nil {
+		return nil, err
+	}
+	r := int(n.Int64())
+	for i := range prizes {
+		if prizes[i].Weight <= 0 {
+			continue
+		}
+		if r < prizes[i].Weight {
+			return &prizes[i], nil
+		}
+		r -= prizes[i].Weight
+	}
+	return nil, ErrNoPrizes
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPickPrize(t *testing.T) {
+	const rounds = 2000
+
+	tests := []struct {
+		name    string
+		prizes  []WheelPrize
+		wantErr error
+		// Индексы секторов, которые могут выпасть; каждый должен выпасть хотя бы раз
+		want []int
+	}{
+		{name: "empty table", prizes: nil, wantErr: ErrNoPrizes},
+		{name: "all weights zero", prizes: []WheelPrize{{Weight: 0}, {Weight: 0}}, wantErr: ErrNoPrizes},
+		{name: "negative weights only", prizes: []WheelPrize{{Weight: -5}}, wantErr: ErrNoPrizes},
+		{name: "single prize", prizes: []WheelPrize{{Weight: 1}}, want: []int{0}},
+		{name: "zero weight never wins", prizes: []WheelPrize{{Weight: 0}, {Weight: 3}, {Weight: 0}}, want: []int{1}},
+		{name: "negative weight never wins", prizes: []WheelPrize{{Weight: 2}, {Weight: -10}, {Weight: 2}}, want: []int{0, 2}},
+		{name: "all positive prizes win", prizes: []WheelPrize{{Weight: 1}, {Weight: 1}, {Weight: 1}}, want: []int{0, 1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.wantErr != nil {
+				if _, err := PickPrize(tt.prizes); !errors.Is(err, tt.wantErr) {
+					t.Fatalf("PickPrize() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			allowed := make(map[int]bool, len(tt.want))
+			for _, i := range tt.want {
+				allowed[i] = true
+			}
+			seen := make(map[int]bool)
+			for n := 0; n < rounds; n++ {
+				p, err := PickPrize(tt.prizes)
+				if err != nil {
+					t.Fatalf("PickPrize() error = %v", err)
+				}
+				i := prizeIndex(tt.prizes, p)
+				if !allowed[i] {
+					t.Fatalf("PickPrize() picked prize %d, want one of %v", i, tt.want)
+				}
+				seen[i] = true
+			}
+			for _, i := range tt.want {
+				if !seen[i] {
+					t.Errorf("prize %d never picked in %d rounds", i, rounds)
+				}
+			}
+		})
+	}
+}
+
+// Веса влияют на частоту: сектор с весом 9 выпадает заметно чаще сектора с весом 1
+func TestPickPrizeWeights(t *testing.T) {
+	const rounds = 10000
+	prizes := []WheelPrize{{Weight: 1}, {Weight: 9}}
+
+	counts := make([]int, len(prizes))
+	for n := 0; n < rounds; n++ {
+		p, err := PickPrize(prizes)
+		if err != nil {
+			t.Fatalf("PickPrize() error = %v", err)
+		}
+		counts[prizeIndex(prizes, p)]++
+	}
+
+	// Ожидаем 10% и 90%; допуск с большим запасом, чтобы тест не мигал
+	if share := float64(counts[0]) / rounds; share < 0.05 || share > 0.15 {
+		t.Errorf("prize with weight 1 picked %.1f%% of the time, want about 10%%", share*100)
+	}
+}
+
+func prizeIndex(prizes []WheelPrize, p *WheelPrize) int {
+	for i := range prizes {
+		if &prizes[i] == p {
+			return i
+		}
+	}
+	return -1
+}
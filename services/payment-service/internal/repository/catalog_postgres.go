@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	"payment-service/internal/domain"
+
+	"gorm.io/gorm/clause"
+)
+
+// GetCatalogItem ищет строку товара, а если ее нет — цену по умолчанию для его типа
+func (r *PaymentRepository) GetCatalogItem(ctx context.Context, itemType, itemID string) (*domain.CatalogItem, error) {
+	var item domain.CatalogItem
+	err := r.db.WithContext(ctx).
+		Where("item_type = ? AND item_id IN ?", itemType, []string{itemID, ""}).
+		Order("item_id desc").
+		First(&item).Error
+	return &item, err
+}
+
+// Весь каталог или товары одного типа
+func (r *PaymentRepository) ListCatalog(ctx context.Context, itemType string) ([]domain.CatalogItem, error) {
+	query := r.db.WithContext(ctx)
+	if itemType != "" {
+		query = query.Where("item_type = ?", itemType)
+	}
+	var items []domain.CatalogItem
+	err := query.Order("item_type, item_id").Find(&items).Error
+	return items, err
+}
+
+// EnsureCatalogDefaults добавляет недостающие строки, уже заданные цены не трогает
+func (r *PaymentRepository) EnsureCatalogDefaults(ctx context.Context, items []domain.CatalogItem) error {
+	return r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&items).Error
+}
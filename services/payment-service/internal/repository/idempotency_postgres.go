@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"payment-service/internal/domain"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReserveIdempotencyKey занимает ключ за операцией. Если ключ уже занят, возвращает
+// существующую запись и false. Просроченные записи и незавершенные записи старше
+// staleBefore (сервис упал посреди операции) считаются свободными.
+func (r *PaymentRepository) ReserveIdempotencyKey(ctx context.Context, rec *domain.IdempotencyRecord, staleBefore time.Time) (*domain.IdempotencyRecord, bool, error) {
+	var existing domain.IdempotencyRecord
+	created := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ? AND key = ?", rec.UserID, rec.Key).
+			Where("expires_at < ? OR (completed = false AND created_at < ?)", time.Now(), staleBefore).
+			Delete(&domain.IdempotencyRecord{}).Error; err != nil {
+			return err
+		}
+
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(rec)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 1 {
+			created = true
+			return nil
+		}
+		return tx.Where("user_id = ? AND key = ?", rec.UserID, rec.Key).First(&existing).Error
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if created {
+		return rec, true, nil
+	}
+	return &existing, false, nil
+}
+
+// CompleteIdempotencyKey сохраняет ответ операции для повторов
+func (r *PaymentRepository) CompleteIdempotencyKey(ctx context.Context, userID, key string, response []byte) error {
+	return r.db.WithContext(ctx).Model(&domain.IdempotencyRecord{}).
+		Where("user_id = ? AND key = ?", userID, key).
+		Updates(map[string]interface{}{"completed": true, "response": response}).Error
+}
+
+// ReleaseIdempotencyKey освобождает ключ после неудачной операции, чтобы ее можно было повторить
+func (r *PaymentRepository) ReleaseIdempotencyKey(ctx context.Context, userID, key string) error {
+	return r.db.WithContext(ctx).
+		Where("user_id = ? AND key = ? AND completed = false", userID, key).
+		Delete(&domain.IdempotencyRecord{}).Error
+}
+
+// DeleteExpiredIdempotencyKeys удаляет записи, срок хранения которых истек
+func (r *PaymentRepository) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	res := r.db.WithContext(ctx).Where("expires_at < ?", now).Delete(&domain.IdempotencyRecord{})
+	return res.RowsAffected, res.Error
+}
+
+// DeleteIdempotencyKeys удаляет сохраненные ответы пользователя при удалении аккаунта
+func (r *PaymentRepository) DeleteIdempotencyKeys(ctx context.Context, userID string) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&domain.IdempotencyRecord{}).Error
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"payment-service/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+func (r *PaymentRepository) CreateOrder(ctx context.Context, o *domain.Order) error {
+	return r.db.WithContext(ctx).Create(o).Error
+}
+
+func (r *PaymentRepository) GetOrder(ctx context.Context, id uuid.UUID) (*domain.Order, error) {
+	var o domain.Order
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&o).Error
+	return &o, err
+}
+
+// Заказ, созданный запросом с этим Idempotency-Key
+func (r *PaymentRepository) GetOrderByIdempotencyKey(ctx context.Context, userID, key string) (*domain.Order, error) {
+	var o domain.Order
+	err := r.db.WithContext(ctx).Where("user_id = ? AND idempotency_key = ?", userID, key).First(&o).Error
+	return &o, err
+}
+
+// UpdateOrder переводит заказ из статуса from в o.Status. false — заказ уже
+// продвинул кто-то другой (воркер или параллельный запрос)
+func (r *PaymentRepository) UpdateOrder(ctx context.Context, o *domain.Order, from string) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&domain.Order{}).
+		Where("id = ? AND status = ?", o.ID, from).
+		Updates(map[string]interface{}{
+			"status":       o.Status,
+			"compensating": o.Compensating,
+			"last_error":   o.LastError,
+			"updated_at":   time.Now(),
+		})
+	return res.RowsAffected == 1, res.Error
+}
+
+// Заказы пользователя, новые первыми. Пустой userID или status — без фильтра
+func (r *PaymentRepository) ListOrders(ctx context.Context, userID, status string, limit, offset int) ([]domain.Order, int64, error) {
+	query := r.db.WithContext(ctx).Model(&domain.Order{})
+	if userID != "" {
+		query = query.Where("user_id = ?", userID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var orders []domain.Order
+	err := query.Order("created_at desc").Limit(limit).Offset(offset).Find(&orders).Error
+	return orders, total, err
+}
+
+// Незавершенные заказы, которые не двигались с before: их процесс упал посреди покупки
+func (r *PaymentRepository) ListStuckOrders(ctx context.Context, before time.Time, limit int) ([]domain.Order, error) {
+	var orders []domain.Order
+	err := r.db.WithContext(ctx).
+		Where("status IN ? AND updated_at < ?", []string{domain.OrderPending, domain.OrderDebited}, before).
+		Order("created_at").
+		Limit(limit).
+		Find(&orders).Error
+	return orders, err
+}
+
+// Все заказы пользователя (для выгрузки данных)
+func (r *PaymentRepository) UserOrders(ctx context.Context, userID string) ([]domain.Order, error) {
+	var orders []domain.Order
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at").Find(&orders).Error
+	return orders, err
+}
+
+func (r *PaymentRepository) DeleteOrders(ctx context.Context, userID string) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&domain.Order{}).Error
+}
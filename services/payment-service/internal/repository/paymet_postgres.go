@@ -5,6 +5,7 @@ import (
 	"payment-service/internal/domain"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type PaymentRepository struct {
@@ -28,13 +29,6 @@ func (r *PaymentRepository) GetPromoWithPlan(ctx context.Context, code string) (
 	return &promo, err
 }
 
-// Увеличение счетчика использований
-func (r *PaymentRepository) IncrementUsage(ctx context.Context, code string) error {
-	return r.db.WithContext(ctx).Model(&domain.PromoCode{}).
-		Where("code = ?", code).
-		Update("used_count", gorm.Expr("used_count + 1")).Error
-}
-
 // Получить все планы
 func (r *PaymentRepository) GetAllPlans(ctx context.Context) ([]domain.Plan, error) {
 	var plans []domain.Plan
@@ -42,19 +36,56 @@ func (r *PaymentRepository) GetAllPlans(ctx context.Context) ([]domain.Plan, err
 	return plans, err
 }
 
-// Проверка: активировал ли этот юзер этот код ранее?
-func (r *PaymentRepository) IsPromoActivatedByUser(ctx context.Context, userID, code string) (bool, error) {
-	var count int64
-	err := r.db.WithContext(ctx).Model(&domain.PromoActivation{}).
-		Where("user_id = ? AND code = ?", userID, code).
-		Count(&count).Error
-	return count > 0, err
+// ActivatePromo записывает активацию и занимает одно использование кода в одной транзакции,
+// так что два параллельных запроса не активируют код дважды и не превысят MaxUses.
+// false — пользователь уже активировал код; ErrPromoExhausted — использования кончились
+func (r *PaymentRepository) ActivatePromo(ctx context.Context, userID, activationCode, promoCode string) (bool, error) {
+	activated := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&domain.PromoActivation{
+			UserID: userID,
+			Code:   activationCode,
+		})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		res = tx.Model(&domain.PromoCode{}).
+			Where("code = ? AND (max_uses <= 0 OR used_count < max_uses)", promoCode).
+			Update("used_count", gorm.Expr("used_count + 1"))
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return domain.ErrPromoExhausted
+		}
+		activated = true
+		return nil
+	})
+	return activated, err
+}
+
+// ReleasePromo снимает активацию, если бонус начислить не удалось: код можно ввести снова
+func (r *PaymentRepository) ReleasePromo(ctx context.Context, userID, activationCode, promoCode string) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Where("user_id = ? AND code = ?", userID, activationCode).Delete(&domain.PromoActivation{})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		return tx.Model(&domain.PromoCode{}).
+			Where("code = ? AND used_count > 0", promoCode).
+			Update("used_count", gorm.Expr("used_count - 1")).Error
+	})
+}
+
+// Активации промокодов пользователя (для выгрузки данных)
+func (r *PaymentRepository) ListActivations(ctx context.Context, userID string) ([]domain.PromoActivation, error) {
+	var items []domain.PromoActivation
+	err := r.db.WithContext(ctx).Where("user_id = ?", userID).Order("created_at").Find(&items).Error
+	return items, err
 }
 
-// Записать факт активации
-func (r *PaymentRepository) SaveActivation(ctx context.Context, userID, code string) error {
-	return r.db.WithContext(ctx).Create(&domain.PromoActivation{
-		UserID: userID,
-		Code:   code,
-	}).Error
+// Удалить все активации пользователя при удалении аккаунта.
+// UsedCount промокодов не уменьшаем: код был потрачен.
+func (r *PaymentRepository) DeleteActivations(ctx context.Context, userID string) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&domain.PromoActivation{}).Error
 }
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"payment-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+func (r *PaymentRepository) ListWheelPrizes(ctx context.Context) ([]domain.WheelPrize, error) {
+	var prizes []domain.WheelPrize
+	err := r.db.WithContext(ctx).Order("id").Find(&prizes).Error
+	return prizes, err
+}
+
+// ReplaceWheelPrizes заменяет таблицу призов целиком, чтобы вращение не увидело ее наполовину измененной
+func (r *PaymentRepository) ReplaceWheelPrizes(ctx context.Context, prizes []domain.WheelPrize) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("1 = 1").Delete(&domain.WheelPrize{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&prizes).Error
+	})
+}
+
+// EnsureWheelPrizes заполняет пустую таблицу призов значениями по умолчанию
+func (r *PaymentRepository) EnsureWheelPrizes(ctx context.Context, prizes []domain.WheelPrize) error {
+	var count int64
+	if err := r.db.WithContext(ctx).Model(&domain.WheelPrize{}).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(&prizes).Error
+}
+
+// CreateSpin сохраняет вращение. false — бесплатное вращение за этот день уже было
+func (r *PaymentRepository) CreateSpin(ctx context.Context, spin *domain.WheelSpin) (bool, error) {
+	res := r.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(spin)
+	return res.RowsAffected == 1, res.Error
+}
+
+func (r *PaymentRepository) GetSpin(ctx context.Context, id uuid.UUID) (*domain.WheelSpin, error) {
+	var spin domain.WheelSpin
+	err := r.db.WithContext(ctx).Where("id = ?", id).First(&spin).Error
+	return &spin, err
+}
+
+// Вращение, созданное запросом с этим Idempotency-Key
+func (r *PaymentRepository) GetSpinByIdempotencyKey(ctx context.Context, userID, key string) (*domain.WheelSpin, error) {
+	var spin domain.WheelSpin
+	err := r.db.WithContext(ctx).Where("user_id = ? AND idempotency_key = ?", userID, key).First(&spin).Error
+	return &spin, err
+}
+
+// UpdateSpin переводит вращение из статуса from в spin.Status. false — вращение уже
+// продвинул кто-то другой. Вращение с освобожденным днем пишет FreeDay = nil
+func (r *PaymentRepository) UpdateSpin(ctx context.Context, spin *domain.WheelSpin, from string) (bool, error) {
+	res := r.db.WithContext(ctx).Model(&domain.WheelSpin{}).
+		Where("id = ? AND status = ?", spin.ID, from).
+		Updates(map[string]interface{}{
+			"status":       spin.Status,
+			"compensating": spin.Compensating,
+			"error":        spin.Error,
+			"free_day":     spin.FreeDay,
+			"updated_at":   time.Now(),
+		})
+	return res.RowsAffected == 1, res.Error
+}
+
+// Незавершенные вращения, которые не двигались с before
+func (r *PaymentRepository) ListStuckSpins(ctx context.Context, before time.Time, limit int) ([]domain.WheelSpin, error) {
+	var spins []domain.WheelSpin
+	err := r.db.WithContext(ctx).
+		Where("status IN ? AND updated_at < ?", []string{domain.SpinPending, domain.SpinDebited}, before).
+		Order("created_at").
+		Limit(limit).
+		Find(&spins).Error
+	return spins, err
+}
+
+// Было ли у пользователя бесплатное вращение в этот день
+func (r *PaymentRepository) HasFreeSpin(ctx context.Context, userID, day string) (bool, error) {
+	var count int64
+	err := r.db.WithContext(ctx).Model(&domain.WheelSpin{}).
+		Where("user_id = ? AND free_day = ?", userID, day).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Вращения пользователя, новые первыми
+func (r *PaymentRepository) ListSpins(ctx context.Context, userID string, limit, offset int) ([]domain.WheelSpin, int64, error) {
+	query := r.db.WithContext(ctx).Model(&domain.WheelSpin{}).Where("user_id = ?", userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	var spins []domain.WheelSpin
+	err := query.Order("created_at desc").Limit(limit).Offset(offset).Find(&spins).Error
+	return spins, total, err
+}
+
+func (r *PaymentRepository) DeleteSpins(ctx context.Context, userID string) error {
+	return r.db.WithContext(ctx).Where("user_id = ?", userID).Delete(&domain.WheelSpin{}).Error
+}
@@ -0,0 +1,91 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"payment-service/internal/domain"
+	coursepb "payment-service/pkg/coursepb/proto/course"
+	paymentpb "payment-service/pkg/paymentpb/proto/payment"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// catalogItem находит товар в каталоге и проверяет, что он продается сейчас.
+// Для курса название и обложку подставляет Course Service, заодно проверяя, что курс существует.
+func (s *PaymentServer) catalogItem(ctx context.Context, itemType, itemID string) (*domain.CatalogItem, error) {
+	switch itemType {
+	case domain.ItemCourse:
+	case domain.ItemAvatar:
+		if _, err := strconv.Atoi(itemID); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "неверный ID аватарки")
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "неизвестный тип товара")
+	}
+
+	item, err := s.repo.GetCatalogItem(ctx, itemType, itemID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "Товар не найден")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Не удалось получить цену товара")
+	}
+	if !item.Available(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "Товар сейчас не продается")
+	}
+
+	if itemType == domain.ItemCourse {
+		res, err := s.courseClient.GetCourse(ctx, &coursepb.GetCourseRequest{CourseId: itemID})
+		switch status.Code(err) {
+		case codes.OK:
+		case codes.NotFound, codes.InvalidArgument:
+			return nil, status.Error(codes.NotFound, "Курс не найден")
+		default:
+			return nil, status.Error(codes.Internal, "Не удалось получить данные курса")
+		}
+		item.Title = res.Course.GetTitle()
+		item.CoverURL = res.Course.GetCoverUrl()
+	}
+	return item, nil
+}
+
+// GetCatalog отдает товары, которые продаются сейчас, с ценами после скидки
+func (s *PaymentServer) GetCatalog(ctx context.Context, req *paymentpb.GetCatalogRequest) (*paymentpb.GetCatalogResponse, error) {
+	items, err := s.repo.ListCatalog(ctx, req.ItemType)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Не удалось получить каталог")
+	}
+
+	now := time.Now()
+	res := &paymentpb.GetCatalogResponse{Items: make([]*paymentpb.CatalogItem, 0, len(items))}
+	for i := range items {
+		item := &items[i]
+		if !item.Available(now) {
+			continue
+		}
+		info := &paymentpb.CatalogItem{
+			ItemType:           item.ItemType,
+			ItemId:             item.ItemID,
+			Title:              item.Title,
+			CoverUrl:           item.CoverURL,
+			SnowflakePrice:     int32(item.PriceAt(now)),
+			RubPrice:           int32(item.RubPriceAt(now)),
+			BaseSnowflakePrice: int32(item.SnowflakePrice),
+			BaseRubPrice:       int32(item.RubPrice),
+			DiscountPercent:    int32(item.Discount(now)),
+		}
+		if item.DiscountUntil != nil && info.DiscountPercent > 0 {
+			info.DiscountUntil = item.DiscountUntil.Unix()
+		}
+		if item.AvailableUntil != nil {
+			info.AvailableUntil = item.AvailableUntil.Unix()
+		}
+		res.Items = append(res.Items, info)
+	}
+	return res, nil
+}
@@ -0,0 +1,117 @@
+package grpc_server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"payment-service/internal/domain"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	maxIdempotencyKeyLen = 255
+	// Незавершенная операция старше этого срока считается брошенной, ключ можно занять заново
+	idempotencyStaleAfter = time.Minute
+)
+
+// runIdempotent выполняет операцию один раз на ключ пользователя. Повтор в пределах
+// срока хранения получает сохраненный ответ первого вызова. Неудачный вызов ключ
+// освобождает: ошибки не сохраняются, запрос можно повторить с тем же ключом.
+func runIdempotent[T proto.Message](ctx context.Context, s *PaymentServer, userID, key, operation string, req proto.Message, stored T, run func() (T, error)) (T, error) {
+	var zero T
+	if key == "" {
+		return run()
+	}
+	if len(key) > maxIdempotencyKeyLen {
+		return zero, status.Error(codes.InvalidArgument, "Слишком длинный ключ идемпотентности")
+	}
+
+	hash, err := requestHash(req)
+	if err != nil {
+		return zero, status.Error(codes.Internal, "Ошибка обработки запроса")
+	}
+	now := time.Now()
+	rec, created, err := s.repo.ReserveIdempotencyKey(ctx, &domain.IdempotencyRecord{
+		UserID:      userID,
+		Key:         key,
+		Operation:   operation,
+		RequestHash: hash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.idempotencyTTL),
+	}, now.Add(-idempotencyStaleAfter))
+	if err != nil {
+		return zero, status.Error(codes.Internal, "Ошибка обработки запроса")
+	}
+
+	if !created {
+		if rec.Operation != operation || rec.RequestHash != hash {
+			return zero, status.Error(codes.InvalidArgument, "Ключ идемпотентности уже использован для другого запроса")
+		}
+		if !rec.Completed {
+			return zero, status.Error(codes.Aborted, "Запрос с этим ключом еще выполняется")
+		}
+		if err := proto.Unmarshal(rec.Response, stored); err != nil {
+			return zero, status.Error(codes.Internal, "Ошибка обработки запроса")
+		}
+		return stored, nil
+	}
+
+	resp, err := run()
+	if err != nil {
+		if relErr := s.repo.ReleaseIdempotencyKey(ctx, userID, key); relErr != nil {
+			log.Printf("Idempotency: failed to release %s of %s: %v", key, userID, relErr)
+		}
+		return zero, err
+	}
+
+	// Операция уже проведена: если ответ не сохранился, повтор дождется
+	// idempotencyStaleAfter и выполнится заново. Покупка продолжит тот же заказ,
+	// а промокод упрется в записанную активацию и вернет AlreadyExists
+	data, err := proto.Marshal(resp)
+	if err == nil {
+		err = s.repo.CompleteIdempotencyKey(ctx, userID, key, data)
+	}
+	if err != nil {
+		log.Printf("Idempotency: failed to store response for %s of %s: %v", key, userID, err)
+	}
+	return resp, nil
+}
+
+// requestHash — отпечаток параметров запроса
+func requestHash(req proto.Message) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// RunIdempotencyCleanup удаляет ответы с истекшим сроком хранения.
+// Блокируется до отмены ctx.
+func (s *PaymentServer) RunIdempotencyCleanup(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := s.repo.DeleteExpiredIdempotencyKeys(ctx, time.Now())
+			if err != nil {
+				log.Printf("Idempotency cleanup failed: %v", err)
+				continue
+			}
+			if n > 0 {
+				log.Printf("Idempotency cleanup: %d expired keys removed", n)
+			}
+		}
+	}
+}
@@ -0,0 +1,338 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"payment-service/internal/domain"
+	paymentpb "payment-service/pkg/paymentpb/proto/payment"
+
+	userpb "github.com/waste3d/gameplatform-api/services/user-service/pkg/userpb/proto/user"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	// Незавершенный заказ, который не двигался дольше этого срока, подхватывает воркер
+	orderStuckAfter = time.Minute
+	// Сколько заказов восстанавливаем за один проход воркера
+	orderRecoveryBatch = 100
+
+	ordersDefaultLimit = 20
+	ordersMaxLimit     = 100
+)
+
+var orderStatuses = map[string]bool{
+	domain.OrderPending:  true,
+	domain.OrderDebited:  true,
+	domain.OrderGranted:  true,
+	domain.OrderRefunded: true,
+	domain.OrderFailed:   true,
+}
+
+// openOrder создает заказ. Повтор запроса с тем же Idempotency-Key продолжает
+// ранее созданный заказ, а не заводит новый
+func (s *PaymentServer) openOrder(ctx context.Context, req *paymentpb.PurchaseItemRequest, item *domain.CatalogItem) (*domain.Order, error) {
+	if req.IdempotencyKey != "" {
+		o, err := s.repo.GetOrderByIdempotencyKey(ctx, req.UserId, req.IdempotencyKey)
+		if err == nil {
+			return o, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+
+	o := &domain.Order{
+		ID:             uuid.New(),
+		UserID:         req.UserId,
+		ItemType:       req.ItemType,
+		ItemID:         req.ItemId,
+		CourseTitle:    item.Title,
+		CourseCoverURL: item.CoverURL,
+		Price:          item.PriceAt(time.Now()),
+		Status:         domain.OrderPending,
+	}
+	if req.IdempotencyKey != "" {
+		key := req.IdempotencyKey
+		o.IdempotencyKey = &key
+	}
+	return o, s.repo.CreateOrder(ctx, o)
+}
+
+// processOrder ведет заказ по шагам до конечного статуса: списание, выдача,
+// при ошибке выдачи — возврат. Ошибка означает, что заказ остался незавершенным
+// и его продолжит воркер; каждый шаг можно повторить без двойного списания.
+func (s *PaymentServer) processOrder(ctx context.Context, o *domain.Order) error {
+	for {
+		var err error
+		switch {
+		case o.Status == domain.OrderPending:
+			err = s.debitOrder(ctx, o)
+		case o.Status == domain.OrderDebited && o.Compensating:
+			err = s.refundOrder(ctx, o)
+		case o.Status == domain.OrderDebited:
+			err = s.grantOrder(ctx, o)
+		default:
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *PaymentServer) debitOrder(ctx context.Context, o *domain.Order) error {
+	// Товар со скидкой 100% бесплатен: списывать нечего, а нулевую сумму User Service отвергает
+	if o.Price <= 0 {
+		return s.moveOrder(ctx, o, func(o *domain.Order) { o.Status = domain.OrderDebited })
+	}
+	_, err := s.userClient.ChangeBalance(ctx, &userpb.ChangeBalanceRequest{
+		UserId:         o.UserID,
+		Amount:         int32(-o.Price),
+		Reason:         "purchase",
+		ReferenceId:    o.ItemType + ":" + o.ItemID,
+		IdempotencyKey: "purchase:" + o.ID.String(),
+	})
+	if err != nil {
+		if !definitiveError(err) {
+			s.noteOrderError(ctx, o, err)
+			return err
+		}
+		// Снежинки не списаны: заказ закрывается без возврата
+		return s.moveOrder(ctx, o, func(o *domain.Order) {
+			o.Status = domain.OrderFailed
+			o.LastError = debitFailure(err)
+		})
+	}
+	return s.moveOrder(ctx, o, func(o *domain.Order) { o.Status = domain.OrderDebited })
+}
+
+func (s *PaymentServer) grantOrder(ctx context.Context, o *domain.Order) error {
+	if err := s.grantItem(ctx, o); err != nil {
+		// Ответ мог потеряться после выдачи — возврат тогда оставил бы товар бесплатным
+		if !definitiveError(err) {
+			s.noteOrderError(ctx, o, err)
+			return err
+		}
+		log.Printf("Order %s: grant failed, refunding: %v", o.ID, err)
+		return s.moveOrder(ctx, o, func(o *domain.Order) {
+			o.Compensating = true
+			o.LastError = err.Error()
+		})
+	}
+	return s.moveOrder(ctx, o, func(o *domain.Order) { o.Status = domain.OrderGranted })
+}
+
+func (s *PaymentServer) refundOrder(ctx context.Context, o *domain.Order) error {
+	if o.Price <= 0 {
+		return s.moveOrder(ctx, o, func(o *domain.Order) {
+			o.Status = domain.OrderRefunded
+			o.Compensating = false
+		})
+	}
+	_, err := s.userClient.ChangeBalance(ctx, &userpb.ChangeBalanceRequest{
+		UserId:         o.UserID,
+		Amount:         int32(o.Price),
+		Reason:         "refund",
+		ReferenceId:    o.ItemType + ":" + o.ItemID,
+		IdempotencyKey: "refund:" + o.ID.String(),
+	})
+	if err != nil {
+		if !definitiveError(err) {
+			s.noteOrderError(ctx, o, err)
+			return err
+		}
+		// Вернуть некуда (например, профиль удален): заказ остается с Compensating для разбора
+		log.Printf("Order %s: refund rejected: %v", o.ID, err)
+		return s.moveOrder(ctx, o, func(o *domain.Order) {
+			o.Status = domain.OrderFailed
+			o.LastError = "refund rejected: " + err.Error()
+		})
+	}
+	return s.moveOrder(ctx, o, func(o *domain.Order) {
+		o.Status = domain.OrderRefunded
+		o.Compensating = false
+	})
+}
+
+// Причина отказа в списании, по которой PurchaseItem отличает нехватку снежинок от прочих ошибок
+const orderInsufficientFunds = "insufficient funds"
+
+func debitFailure(err error) string {
+	if status.Code(err) == codes.FailedPrecondition {
+		return orderInsufficientFunds
+	}
+	return err.Error()
+}
+
+// definitiveError — запрос отвергнут, и повтор ничего не изменит. Таймаут, недоступность
+// и Internal неоднозначны: операция могла пройти, поэтому такой шаг повторяется
+func definitiveError(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok {
+		// Не ответ gRPC: запрос не удалось даже собрать, до User Service он не дошел
+		return true
+	}
+	switch st.Code() {
+	case codes.InvalidArgument, codes.NotFound, codes.FailedPrecondition, codes.PermissionDenied,
+		codes.ResourceExhausted, codes.AlreadyExists, codes.OutOfRange, codes.Unimplemented:
+		return true
+	}
+	return false
+}
+
+// noteOrderError запоминает, почему шаг не прошел; заказ остается в том же статусе и ждет повтора
+func (s *PaymentServer) noteOrderError(ctx context.Context, o *domain.Order, cause error) {
+	if err := s.moveOrder(ctx, o, func(o *domain.Order) { o.LastError = cause.Error() }); err != nil {
+		log.Printf("Order %s: failed to save error: %v", o.ID, err)
+	}
+}
+
+// grantItem выдает товар через User Service
+func (s *PaymentServer) grantItem(ctx context.Context, o *domain.Order) error {
+	switch o.ItemType {
+	case domain.ItemCourse:
+		_, err := s.userClient.StartCourse(ctx, &userpb.StartCourseRequest{
+			UserId:   o.UserID,
+			CourseId: o.ItemID,
+			Title:    o.CourseTitle,
+			CoverUrl: o.CourseCoverURL,
+		})
+		return err
+	case domain.ItemAvatar:
+		avatarID, err := strconv.Atoi(o.ItemID)
+		if err != nil {
+			return err
+		}
+		_, err = s.userClient.UnlockAvatar(ctx, &userpb.UnlockAvatarRequest{
+			UserId:   o.UserID,
+			AvatarId: int32(avatarID),
+		})
+		return err
+	default:
+		return errors.New("unknown item type " + o.ItemType)
+	}
+}
+
+// moveOrder сохраняет следующий шаг заказа. Если заказ уже продвинул кто-то
+// другой, перечитывает его и продолжает с того статуса, что в базе
+func (s *PaymentServer) moveOrder(ctx context.Context, o *domain.Order, step func(*domain.Order)) error {
+	from := o.Status
+	next := *o
+	step(&next)
+	ok, err := s.repo.UpdateOrder(ctx, &next, from)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		current, err := s.repo.GetOrder(ctx, o.ID)
+		if err != nil {
+			return err
+		}
+		next = *current
+	}
+	*o = next
+	return nil
+}
+
+// RunOrderRecovery сразу после старта и затем по таймеру продолжает заказы
+// и вращения колеса, брошенные на полпути. Блокируется до отмены ctx.
+func (s *PaymentServer) RunOrderRecovery(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		s.recoverOrders(ctx)
+		s.recoverSpins(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *PaymentServer) recoverOrders(ctx context.Context) {
+	orders, err := s.repo.ListStuckOrders(ctx, time.Now().Add(-orderStuckAfter), orderRecoveryBatch)
+	if err != nil {
+		log.Printf("Order recovery: failed to load: %v", err)
+		return
+	}
+
+	for i := range orders {
+		o := &orders[i]
+		from := o.Status
+		if err := s.processOrder(ctx, o); err != nil {
+			log.Printf("Order recovery: %s of %s will be retried: %v", o.ID, o.UserID, err)
+			continue
+		}
+		log.Printf("[ORDERS] order %s of %s: %s -> %s", o.ID, o.UserID, from, o.Status)
+	}
+}
+
+// GetOrder: с user_id отдает только заказ этого пользователя, без него — любой (для админов)
+func (s *PaymentServer) GetOrder(ctx context.Context, req *paymentpb.GetOrderRequest) (*paymentpb.Order, error) {
+	id, err := uuid.Parse(req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid order id")
+	}
+	o, err := s.repo.GetOrder(ctx, id)
+	if errors.Is(err, gorm.ErrRecordNotFound) || (err == nil && req.UserId != "" && o.UserID != req.UserId) {
+		return nil, status.Error(codes.NotFound, "order not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get order")
+	}
+	return orderInfo(o), nil
+}
+
+func (s *PaymentServer) ListOrders(ctx context.Context, req *paymentpb.ListOrdersRequest) (*paymentpb.ListOrdersResponse, error) {
+	if req.Status != "" && !orderStatuses[req.Status] {
+		return nil, status.Error(codes.InvalidArgument, "unknown order status")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = ordersDefaultLimit
+	}
+	if limit > ordersMaxLimit {
+		limit = ordersMaxLimit
+	}
+	offset := int(req.Offset)
+	if offset < 0 {
+		offset = 0
+	}
+
+	orders, total, err := s.repo.ListOrders(ctx, req.UserId, req.Status, limit, offset)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list orders")
+	}
+	res := &paymentpb.ListOrdersResponse{
+		Orders:     make([]*paymentpb.Order, 0, len(orders)),
+		TotalCount: total,
+	}
+	for i := range orders {
+		res.Orders = append(res.Orders, orderInfo(&orders[i]))
+	}
+	return res, nil
+}
+
+func orderInfo(o *domain.Order) *paymentpb.Order {
+	return &paymentpb.Order{
+		Id:        o.ID.String(),
+		UserId:    o.UserID,
+		ItemType:  o.ItemType,
+		ItemId:    o.ItemID,
+		Price:     int32(o.Price),
+		Status:    o.Status,
+		LastError: o.LastError,
+		CreatedAt: o.CreatedAt.Unix(),
+		UpdatedAt: o.UpdatedAt.Unix(),
+	}
+}
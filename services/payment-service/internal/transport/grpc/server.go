@@ -2,12 +2,15 @@ package grpc_server
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"strconv"
+	"log"
 	"strings"
 	"time"
 
+	"payment-service/internal/domain"
 	"payment-service/internal/repository"
+	coursepb "payment-service/pkg/coursepb/proto/course"
 	paymentpb "payment-service/pkg/paymentpb/proto/payment"
 
 	userpb "github.com/waste3d/gameplatform-api/services/user-service/pkg/userpb/proto/user"
@@ -16,82 +19,66 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-// Цены на дискретные товары
-const (
-	CoursePurchasePrice = 1000
-	AvatarPurchasePrice = 250
-)
-
 type PaymentServer struct {
 	paymentpb.UnimplementedPaymentServiceServer
 	repo       *repository.PaymentRepository
 	userClient userpb.UserServiceClient
+	// Название и обложка курса для заказа
+	courseClient coursepb.CourseServiceClient
+	// Сколько хранится ответ на запрос с ключом идемпотентности
+	idempotencyTTL time.Duration
+	// Цена вращения колеса после бесплатного за день
+	spinCost int
 }
 
-func NewPaymentServer(repo *repository.PaymentRepository, uc userpb.UserServiceClient) *PaymentServer {
-	return &PaymentServer{repo: repo, userClient: uc}
+func NewPaymentServer(repo *repository.PaymentRepository, uc userpb.UserServiceClient, cc coursepb.CourseServiceClient, idempotencyTTL time.Duration, spinCost int) *PaymentServer {
+	return &PaymentServer{repo: repo, userClient: uc, courseClient: cc, idempotencyTTL: idempotencyTTL, spinCost: spinCost}
 }
 
 // НОВЫЙ МЕТОД ДЛЯ ПОКУПКИ
 func (s *PaymentServer) PurchaseItem(ctx context.Context, req *paymentpb.PurchaseItemRequest) (*paymentpb.PurchaseItemResponse, error) {
-	var price int
-	var grantAction func() error
-
-	switch req.ItemType {
-	case "COURSE":
-		price = CoursePurchasePrice
-		grantAction = func() error {
-			// Для покупки курса вызываем StartCourse в user-service
-			_, err := s.userClient.StartCourse(ctx, &userpb.StartCourseRequest{
-				UserId:   req.UserId,
-				CourseId: req.ItemId,
-				Title:    req.CourseTitle,
-				CoverUrl: req.CourseCoverUrl,
-			})
-			return err
-		}
+	return runIdempotent(ctx, s, req.UserId, req.IdempotencyKey, domain.OperationPurchaseItem, req, &paymentpb.PurchaseItemResponse{},
+		func() (*paymentpb.PurchaseItemResponse, error) { return s.purchaseItem(ctx, req) })
+}
 
-	case "AVATAR":
-		price = AvatarPurchasePrice
-		avatarID, err := strconv.Atoi(req.ItemId)
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, "неверный ID аватарки")
-		}
-		grantAction = func() error {
-			// Для покупки аватарки вызываем UnlockAvatar
-			_, err := s.userClient.UnlockAvatar(ctx, &userpb.UnlockAvatarRequest{
-				UserId:   req.UserId,
-				AvatarId: int32(avatarID),
-			})
-			return err
-		}
+func (s *PaymentServer) purchaseItem(ctx context.Context, req *paymentpb.PurchaseItemRequest) (*paymentpb.PurchaseItemResponse, error) {
+	if err := s.requirePurchaser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
 
-	default:
-		return nil, status.Error(codes.InvalidArgument, "неизвестный тип товара")
+	// Клиенту не доверяем: цена из каталога, метаданные курса — из Course Service
+	item, err := s.catalogItem(ctx, req.ItemType, req.ItemId)
+	if err != nil {
+		return nil, err
 	}
 
-	// 2. Списываем баланс
-	_, err := s.userClient.ChangeBalance(ctx, &userpb.ChangeBalanceRequest{
-		UserId: req.UserId,
-		Amount: int32(-price),
-	})
+	order, err := s.openOrder(ctx, req, item)
 	if err != nil {
-		return nil, status.Error(codes.ResourceExhausted, "Недостаточно снежинок")
+		return nil, status.Error(codes.Internal, "Не удалось создать заказ")
+	}
+	if err := s.processOrder(ctx, order); err != nil {
+		log.Printf("Order %s of %s stopped at %s: %v", order.ID, order.UserID, order.Status, err)
+		// Заказ сохранен, воркер восстановления доведет его до конца
+		if order.Compensating {
+			return nil, status.Error(codes.Internal, "Не удалось выдать товар, средства будут возвращены")
+		}
+		return nil, status.Error(codes.Internal, "Не удалось завершить покупку, заказ будет обработан автоматически")
 	}
 
-	// 3. Выдаем товар
-	if err := grantAction(); err != nil {
-		// Возвращаем деньги в случае ошибки
-		_, _ = s.userClient.ChangeBalance(ctx, &userpb.ChangeBalanceRequest{
-			UserId: req.UserId,
-			Amount: int32(price),
-		})
+	switch {
+	case order.Status == domain.OrderFailed && order.LastError == orderInsufficientFunds:
+		return nil, status.Error(codes.ResourceExhausted, "Недостаточно снежинок")
+	case order.Status == domain.OrderFailed && order.Compensating:
+		return nil, status.Error(codes.Internal, "Не удалось выдать товар и вернуть средства, обратитесь в поддержку")
+	case order.Status == domain.OrderFailed:
+		return nil, status.Error(codes.Internal, "Не удалось списать снежинки")
+	case order.Status == domain.OrderRefunded:
 		return nil, status.Error(codes.Internal, "Не удалось выдать товар, средства возвращены")
 	}
-
 	return &paymentpb.PurchaseItemResponse{
 		Success: true,
 		Message: "Покупка успешно совершена!",
+		OrderId: order.ID.String(),
 	}, nil
 }
 
@@ -111,9 +98,15 @@ func (s *PaymentServer) GetPlans(ctx context.Context, req *paymentpb.GetPlansReq
 	return &paymentpb.GetPlansResponse{Plans: pbPlans}, nil
 }
 
-// RedeemPromo остается без изменений...
 func (s *PaymentServer) RedeemPromo(ctx context.Context, req *paymentpb.RedeemPromoRequest) (*paymentpb.RedeemPromoResponse, error) {
-	// ... (весь ваш код для RedeemPromo остается здесь)
+	return runIdempotent(ctx, s, req.UserId, req.IdempotencyKey, domain.OperationRedeemPromo, req, &paymentpb.RedeemPromoResponse{},
+		func() (*paymentpb.RedeemPromoResponse, error) { return s.redeemPromo(ctx, req) })
+}
+
+func (s *PaymentServer) redeemPromo(ctx context.Context, req *paymentpb.RedeemPromoRequest) (*paymentpb.RedeemPromoResponse, error) {
+	if err := s.requirePurchaser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
 	codeClean := strings.ToUpper(strings.TrimSpace(req.Code))
 	promo, err := s.repo.GetPromoWithPlan(ctx, codeClean)
 	if err != nil {
@@ -125,41 +118,59 @@ func (s *PaymentServer) RedeemPromo(ctx context.Context, req *paymentpb.RedeemPr
 	if promo.MaxUses > 0 && promo.UsedCount >= promo.MaxUses {
 		return nil, status.Error(codes.ResourceExhausted, "Лимит использований этого кода исчерпан")
 	}
-	used, err := s.repo.IsPromoActivatedByUser(ctx, req.UserId, codeClean)
+	// Активация пишется до начисления: параллельный запрос упрется в нее и ничего не начислит
+	activated, err := s.repo.ActivatePromo(ctx, req.UserId, codeClean, promo.Code)
+	if errors.Is(err, domain.ErrPromoExhausted) {
+		return nil, status.Error(codes.ResourceExhausted, "Лимит использований этого кода исчерпан")
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "Ошибка проверки промокода")
 	}
-	if used {
+	if !activated {
 		return nil, status.Error(codes.AlreadyExists, "Вы уже активировали этот промокод")
 	}
-	plan := promo.Plan
-	duration := plan.DefaultDurationDays
-	if promo.OverrideDuration > 0 {
-		duration = promo.OverrideDuration
+
+	res, err := s.grantPromo(ctx, req.UserId, codeClean, promo)
+	if err != nil {
+		// Слоты начисляются с ключом по коду, так что повторная активация не даст их дважды
+		if relErr := s.repo.ReleasePromo(ctx, req.UserId, codeClean, promo.Code); relErr != nil {
+			log.Printf("Promo %s of %s: failed to release activation: %v", codeClean, req.UserId, relErr)
+		}
+		return nil, err
 	}
-	expiresAt := time.Now().Add(time.Duration(duration) * 24 * time.Hour)
+	return res, nil
+}
+
+// grantPromo начисляет то, что дает промокод: слоты для курсов или подписку
+func (s *PaymentServer) grantPromo(ctx context.Context, userID, code string, promo *domain.PromoCode) (*paymentpb.RedeemPromoResponse, error) {
 	if promo.Type == "ONE_COURSE" {
 		slots := promo.ValueInt
 		if slots == 0 {
 			slots = 1
 		}
 		_, err := s.userClient.AddCourseLimit(ctx, &userpb.AddCourseLimitRequest{
-			UserId: req.UserId,
-			Count:  int32(slots),
+			UserId:         userID,
+			Count:          int32(slots),
+			IdempotencyKey: "promo:" + userID + ":" + code,
 		})
 		if err != nil {
 			return nil, status.Error(codes.Internal, "Ошибка начисления слота")
 		}
-		_ = s.repo.IncrementUsage(ctx, promo.Code)
-		_ = s.repo.SaveActivation(ctx, req.UserId, codeClean)
 		return &paymentpb.RedeemPromoResponse{
 			Success:  true,
 			Message:  fmt.Sprintf("Активирован доступ к %d курсу(ам)!", slots),
 			PlanName: "Бонус",
 		}, nil
 	}
-	_, err = s.userClient.SetSubscription(ctx, &userpb.SetSubscriptionRequest{
-		UserId:      req.UserId,
+
+	plan := promo.Plan
+	duration := plan.DefaultDurationDays
+	if promo.OverrideDuration > 0 {
+		duration = promo.OverrideDuration
+	}
+	expiresAt := time.Now().Add(time.Duration(duration) * 24 * time.Hour)
+	_, err := s.userClient.SetSubscription(ctx, &userpb.SetSubscriptionRequest{
+		UserId:      userID,
 		PlanName:    plan.Name,
 		CourseLimit: int32(plan.CourseLimit),
 		DeviceLimit: int32(plan.DeviceLimit),
@@ -169,8 +180,6 @@ func (s *PaymentServer) RedeemPromo(ctx context.Context, req *paymentpb.RedeemPr
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Ошибка активации подписки: %v", err)
 	}
-	_ = s.repo.IncrementUsage(ctx, promo.Code)
-	_ = s.repo.SaveActivation(ctx, req.UserId, codeClean)
 	return &paymentpb.RedeemPromoResponse{
 		Success:   true,
 		Message:   fmt.Sprintf("Подписка '%s' активирована на %d дней", plan.Name, duration),
@@ -178,3 +187,18 @@ func (s *PaymentServer) RedeemPromo(ctx context.Context, req *paymentpb.RedeemPr
 		ExpiresAt: expiresAt.Unix(),
 	}, nil
 }
+
+// requirePurchaser не дает тратить и активировать что-либо забаненным и с неподтвержденной почты
+func (s *PaymentServer) requirePurchaser(ctx context.Context, userID string) error {
+	profile, err := s.userClient.GetProfile(ctx, &userpb.GetProfileRequest{UserId: userID})
+	if err != nil {
+		return status.Error(codes.Internal, "Не удалось проверить профиль")
+	}
+	if profile.Banned {
+		return status.Error(codes.PermissionDenied, "Аккаунт заблокирован")
+	}
+	if !profile.EmailVerified {
+		return status.Error(codes.FailedPrecondition, "Подтвердите email, чтобы совершать покупки")
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package grpc_server
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	paymentpb "payment-service/pkg/paymentpb/proto/payment"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Выгрузка данных пользователя: что хранит о нем Payment Service
+type userDataExport struct {
+	PromoActivations []promoActivationExport `json:"promo_activations"`
+	Orders           []orderExport           `json:"orders"`
+	WheelSpins       []wheelSpinExport       `json:"wheel_spins"`
+}
+
+type wheelSpinExport struct {
+	Free       bool      `json:"free"`
+	Cost       int       `json:"cost"`
+	PrizeType  string    `json:"prize_type"`
+	PrizeValue int       `json:"prize_value"`
+	Status     string    `json:"status"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type orderExport struct {
+	ID        string    `json:"id"`
+	ItemType  string    `json:"item_type"`
+	ItemID    string    `json:"item_id"`
+	Price     int       `json:"price"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type promoActivationExport struct {
+	Code        string    `json:"code"`
+	ActivatedAt time.Time `json:"activated_at"`
+}
+
+// DeleteUserData вызывается Auth Service после льготного периода удаления аккаунта
+func (s *PaymentServer) DeleteUserData(ctx context.Context, req *paymentpb.DeleteUserDataRequest) (*paymentpb.DeleteUserDataResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if err := s.repo.DeleteActivations(ctx, req.UserId); err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete user data")
+	}
+	if err := s.repo.DeleteOrders(ctx, req.UserId); err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete user data")
+	}
+	if err := s.repo.DeleteSpins(ctx, req.UserId); err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete user data")
+	}
+	if err := s.repo.DeleteIdempotencyKeys(ctx, req.UserId); err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete user data")
+	}
+	return &paymentpb.DeleteUserDataResponse{Success: true}, nil
+}
+
+func (s *PaymentServer) ExportUserData(ctx context.Context, req *paymentpb.ExportUserDataRequest) (*paymentpb.ExportUserDataResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	activations, err := s.repo.ListActivations(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to export user data")
+	}
+
+	orders, err := s.repo.UserOrders(ctx, req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to export user data")
+	}
+
+	// Отрицательный limit — без ограничения
+	spins, _, err := s.repo.ListSpins(ctx, req.UserId, -1, 0)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to export user data")
+	}
+
+	export := userDataExport{
+		PromoActivations: make([]promoActivationExport, 0, len(activations)),
+		Orders:           make([]orderExport, 0, len(orders)),
+		WheelSpins:       make([]wheelSpinExport, 0, len(spins)),
+	}
+	for _, a := range activations {
+		export.PromoActivations = append(export.PromoActivations, promoActivationExport{
+			Code:        a.Code,
+			ActivatedAt: a.CreatedAt,
+		})
+	}
+	for _, o := range orders {
+		export.Orders = append(export.Orders, orderExport{
+			ID:        o.ID.String(),
+			ItemType:  o.ItemType,
+			ItemID:    o.ItemID,
+			Price:     o.Price,
+			Status:    o.Status,
+			CreatedAt: o.CreatedAt,
+		})
+	}
+	for _, sp := range spins {
+		export.WheelSpins = append(export.WheelSpins, wheelSpinExport{
+			Free:       sp.Free,
+			Cost:       sp.Cost,
+			PrizeType:  sp.PrizeType,
+			PrizeValue: sp.PrizeValue,
+			Status:     sp.Status,
+			CreatedAt:  sp.CreatedAt,
+		})
+	}
+	data, err := json.Marshal(export)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to export user data")
+	}
+	return &paymentpb.ExportUserDataResponse{Data: data}, nil
+}
@@ -0,0 +1,458 @@
+package grpc_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"payment-service/internal/domain"
+	paymentpb "payment-service/pkg/paymentpb/proto/payment"
+
+	userpb "github.com/waste3d/gameplatform-api/services/user-service/pkg/userpb/proto/user"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	maxWheelPrizes    = 32
+	spinsDefaultLimit = 20
+	spinsMaxLimit     = 100
+)
+
+// День бесплатного вращения считаем по UTC
+func spinDay(now time.Time) string {
+	return now.UTC().Format("2006-01-02")
+}
+
+func (s *PaymentServer) SpinWheel(ctx context.Context, req *paymentpb.SpinWheelRequest) (*paymentpb.SpinWheelResponse, error) {
+	return runIdempotent(ctx, s, req.UserId, req.IdempotencyKey, domain.OperationSpinWheel, req, &paymentpb.SpinWheelResponse{},
+		func() (*paymentpb.SpinWheelResponse, error) { return s.spinWheel(ctx, req) })
+}
+
+func (s *PaymentServer) spinWheel(ctx context.Context, req *paymentpb.SpinWheelRequest) (*paymentpb.SpinWheelResponse, error) {
+	if err := s.requirePurchaser(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+
+	prizes, err := s.repo.ListWheelPrizes(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Не удалось загрузить призы")
+	}
+	spin, err := s.openSpin(ctx, req, prizes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.processSpin(ctx, spin); err != nil {
+		log.Printf("Wheel spin %s of %s stopped at %s: %v", spin.ID, spin.UserID, spin.Status, err)
+		// Вращение сохранено, воркер восстановления доведет его до конца
+		if spin.Compensating {
+			return nil, status.Error(codes.Internal, "Не удалось выдать приз, вращение будет отменено")
+		}
+		return nil, status.Error(codes.Internal, "Не удалось завершить вращение, приз будет выдан автоматически")
+	}
+
+	switch {
+	case spin.Status == domain.SpinFailed && spin.Error == orderInsufficientFunds:
+		return nil, status.Error(codes.ResourceExhausted, "Недостаточно снежинок")
+	case spin.Status == domain.SpinFailed && spin.Compensating:
+		return nil, status.Error(codes.Internal, "Не удалось выдать приз и вернуть средства, обратитесь в поддержку")
+	case spin.Status == domain.SpinFailed:
+		return nil, status.Error(codes.Internal, "Не удалось списать снежинки")
+	case spin.Status == domain.SpinRefunded:
+		return nil, status.Error(codes.Internal, "Не удалось выдать приз, вращение не засчитано")
+	}
+
+	res := &paymentpb.SpinWheelResponse{
+		PrizeType:  spin.PrizeType,
+		PrizeValue: int32(spin.PrizeValue),
+		Message:    prizeMessage(spinPrize(prizes, spin)),
+		SpinId:     spin.ID.String(),
+		Free:       spin.Free,
+		Cost:       int32(spin.Cost),
+	}
+	if profile, err := s.userClient.GetProfile(ctx, &userpb.GetProfileRequest{UserId: spin.UserID}); err == nil {
+		res.RemainingBalance = profile.Balance
+	}
+	return res, nil
+}
+
+// openSpin продолжает вращение, созданное запросом с тем же Idempotency-Key, или крутит колесо заново.
+// Сначала пробуем занять бесплатное вращение дня; платное — только если клиент согласился заплатить
+func (s *PaymentServer) openSpin(ctx context.Context, req *paymentpb.SpinWheelRequest, prizes []domain.WheelPrize) (*domain.WheelSpin, error) {
+	if req.IdempotencyKey != "" {
+		spin, err := s.repo.GetSpinByIdempotencyKey(ctx, req.UserId, req.IdempotencyKey)
+		if err == nil {
+			return spin, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.Internal, "Не удалось сохранить вращение")
+		}
+	}
+
+	prize, err := domain.PickPrize(prizes)
+	if errors.Is(err, domain.ErrNoPrizes) {
+		return nil, status.Error(codes.Unavailable, "Колесо временно недоступно")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Не удалось выбрать приз")
+	}
+
+	day := spinDay(time.Now())
+	spin := &domain.WheelSpin{
+		ID:         uuid.New(),
+		UserID:     req.UserId,
+		Free:       true,
+		FreeDay:    &day,
+		PrizeID:    prize.ID,
+		PrizeType:  prize.Type,
+		PrizeValue: prize.Value,
+		Status:     domain.SpinPending,
+	}
+	if req.IdempotencyKey != "" {
+		key := req.IdempotencyKey
+		spin.IdempotencyKey = &key
+	}
+	created, err := s.repo.CreateSpin(ctx, spin)
+	if err == nil && !created {
+		if !req.Paid {
+			return nil, status.Errorf(codes.AlreadyExists, "Бесплатное вращение сегодня уже использовано, следующее стоит %d снежинок", s.spinCost)
+		}
+		spin.Free = false
+		spin.FreeDay = nil
+		spin.Cost = s.spinCost
+		created, err = s.repo.CreateSpin(ctx, spin)
+		// Платное вращение конфликтует только по ключу: его занял параллельный повтор
+		if err == nil && !created {
+			return nil, status.Error(codes.Aborted, "Запрос с этим ключом еще выполняется")
+		}
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Не удалось сохранить вращение")
+	}
+	return spin, nil
+}
+
+// spinPrize — приз вращения из текущей таблицы. Таблицу могли заменить, пока вращение
+// ждало повтора, тогда подписи нет и сообщение строится по типу и значению
+func spinPrize(prizes []domain.WheelPrize, spin *domain.WheelSpin) *domain.WheelPrize {
+	for i := range prizes {
+		if prizes[i].ID == spin.PrizeID {
+			return &prizes[i]
+		}
+	}
+	return &domain.WheelPrize{Type: spin.PrizeType, Value: spin.PrizeValue}
+}
+
+// processSpin ведет вращение по тем же шагам, что и заказ: списание, выдача приза,
+// при отказе в выдаче — возврат. Ошибка означает, что вращение осталось незавершенным
+// и его продолжит воркер.
+func (s *PaymentServer) processSpin(ctx context.Context, spin *domain.WheelSpin) error {
+	for {
+		var err error
+		switch {
+		case spin.Status == domain.SpinPending:
+			err = s.debitSpin(ctx, spin)
+		case spin.Status == domain.SpinDebited && spin.Compensating:
+			err = s.refundSpin(ctx, spin)
+		case spin.Status == domain.SpinDebited:
+			err = s.grantSpin(ctx, spin)
+		default:
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *PaymentServer) debitSpin(ctx context.Context, spin *domain.WheelSpin) error {
+	if spin.Cost <= 0 {
+		return s.moveSpin(ctx, spin, func(sp *domain.WheelSpin) { sp.Status = domain.SpinDebited })
+	}
+	_, err := s.userClient.ChangeBalance(ctx, &userpb.ChangeBalanceRequest{
+		UserId:         spin.UserID,
+		Amount:         int32(-spin.Cost),
+		Reason:         "wheel_spin",
+		ReferenceId:    spin.ID.String(),
+		IdempotencyKey: "wheel_spin:" + spin.ID.String(),
+	})
+	if err != nil {
+		if !definitiveError(err) {
+			s.noteSpinError(ctx, spin, err)
+			return err
+		}
+		return s.moveSpin(ctx, spin, func(sp *domain.WheelSpin) {
+			sp.Status = domain.SpinFailed
+			sp.Error = debitFailure(err)
+		})
+	}
+	return s.moveSpin(ctx, spin, func(sp *domain.WheelSpin) { sp.Status = domain.SpinDebited })
+}
+
+func (s *PaymentServer) grantSpin(ctx context.Context, spin *domain.WheelSpin) error {
+	if err := s.grantPrize(ctx, spin); err != nil {
+		// Ответ мог потеряться после выдачи — тогда возврат отдал бы приз бесплатно
+		if !definitiveError(err) {
+			s.noteSpinError(ctx, spin, err)
+			return err
+		}
+		log.Printf("Wheel spin %s of %s: grant failed, refunding: %v", spin.ID, spin.UserID, err)
+		return s.moveSpin(ctx, spin, func(sp *domain.WheelSpin) {
+			sp.Compensating = true
+			sp.Error = err.Error()
+		})
+	}
+	return s.moveSpin(ctx, spin, func(sp *domain.WheelSpin) { sp.Status = domain.SpinGranted })
+}
+
+// refundSpin возвращает стоимость платного вращения, а бесплатному — день, чтобы крутить можно было снова
+func (s *PaymentServer) refundSpin(ctx context.Context, spin *domain.WheelSpin) error {
+	if spin.Cost > 0 {
+		_, err := s.userClient.ChangeBalance(ctx, &userpb.ChangeBalanceRequest{
+			UserId:         spin.UserID,
+			Amount:         int32(spin.Cost),
+			Reason:         "wheel_spin",
+			ReferenceId:    spin.ID.String(),
+			IdempotencyKey: "wheel_spin_refund:" + spin.ID.String(),
+		})
+		if err != nil {
+			if !definitiveError(err) {
+				s.noteSpinError(ctx, spin, err)
+				return err
+			}
+			log.Printf("Wheel spin %s of %s: refund rejected: %v", spin.ID, spin.UserID, err)
+			return s.moveSpin(ctx, spin, func(sp *domain.WheelSpin) {
+				sp.Status = domain.SpinFailed
+				sp.Error = "refund rejected: " + err.Error()
+			})
+		}
+	}
+	return s.moveSpin(ctx, spin, func(sp *domain.WheelSpin) {
+		sp.Status = domain.SpinRefunded
+		sp.Compensating = false
+		sp.FreeDay = nil
+	})
+}
+
+// grantPrize выдает приз с ключом по ID вращения, чтобы повтор после таймаута не выдал его дважды.
+// Аватарка идемпотентна сама по себе: повторная выдача уже открытой ничего не меняет
+func (s *PaymentServer) grantPrize(ctx context.Context, spin *domain.WheelSpin) error {
+	var err error
+	switch spin.PrizeType {
+	case domain.PrizeBalance:
+		_, err = s.userClient.ChangeBalance(ctx, &userpb.ChangeBalanceRequest{
+			UserId:         spin.UserID,
+			Amount:         int32(spin.PrizeValue),
+			Reason:         "wheel_prize",
+			ReferenceId:    spin.ID.String(),
+			IdempotencyKey: "wheel_prize:" + spin.ID.String(),
+		})
+	case domain.PrizeSlot:
+		_, err = s.userClient.AddCourseLimit(ctx, &userpb.AddCourseLimitRequest{
+			UserId:         spin.UserID,
+			Count:          int32(spin.PrizeValue),
+			IdempotencyKey: "wheel_prize:" + spin.ID.String(),
+		})
+	case domain.PrizeAvatar:
+		_, err = s.userClient.UnlockAvatar(ctx, &userpb.UnlockAvatarRequest{
+			UserId:   spin.UserID,
+			AvatarId: int32(spin.PrizeValue),
+		})
+	}
+	return err
+}
+
+// noteSpinError запоминает, почему шаг не прошел; вращение остается в том же статусе и ждет повтора
+func (s *PaymentServer) noteSpinError(ctx context.Context, spin *domain.WheelSpin, cause error) {
+	if err := s.moveSpin(ctx, spin, func(sp *domain.WheelSpin) { sp.Error = cause.Error() }); err != nil {
+		log.Printf("Wheel spin %s of %s: failed to save error: %v", spin.ID, spin.UserID, err)
+	}
+}
+
+// moveSpin сохраняет следующий шаг вращения; проигравший гонку перечитывает его из базы
+func (s *PaymentServer) moveSpin(ctx context.Context, spin *domain.WheelSpin, step func(*domain.WheelSpin)) error {
+	from := spin.Status
+	next := *spin
+	step(&next)
+	ok, err := s.repo.UpdateSpin(ctx, &next, from)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		current, err := s.repo.GetSpin(ctx, spin.ID)
+		if err != nil {
+			return err
+		}
+		next = *current
+	}
+	*spin = next
+	return nil
+}
+
+func (s *PaymentServer) recoverSpins(ctx context.Context) {
+	spins, err := s.repo.ListStuckSpins(ctx, time.Now().Add(-orderStuckAfter), orderRecoveryBatch)
+	if err != nil {
+		log.Printf("Wheel recovery: failed to load: %v", err)
+		return
+	}
+
+	for i := range spins {
+		spin := &spins[i]
+		from := spin.Status
+		if err := s.processSpin(ctx, spin); err != nil {
+			log.Printf("Wheel recovery: %s of %s will be retried: %v", spin.ID, spin.UserID, err)
+			continue
+		}
+		log.Printf("[WHEEL] spin %s of %s: %s -> %s", spin.ID, spin.UserID, from, spin.Status)
+	}
+}
+
+func prizeMessage(p *domain.WheelPrize) string {
+	if p.Label != "" {
+		return p.Label
+	}
+	switch p.Type {
+	case domain.PrizeBalance:
+		return fmt.Sprintf("Вы выиграли %d снежинок!", p.Value)
+	case domain.PrizeSlot:
+		return fmt.Sprintf("Вы выиграли %d слот(ов) для курсов!", p.Value)
+	case domain.PrizeAvatar:
+		return "Вы выиграли новую аватарку!"
+	default:
+		return "В этот раз без приза, попробуйте еще!"
+	}
+}
+
+func (s *PaymentServer) GetWheelPrizes(ctx context.Context, req *paymentpb.GetWheelPrizesRequest) (*paymentpb.GetWheelPrizesResponse, error) {
+	prizes, err := s.repo.ListWheelPrizes(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "Не удалось загрузить призы")
+	}
+	res := wheelPrizesInfo(prizes, s.spinCost)
+
+	if req.UserId != "" {
+		used, err := s.repo.HasFreeSpin(ctx, req.UserId, spinDay(time.Now()))
+		if err != nil {
+			return nil, status.Error(codes.Internal, "Не удалось загрузить призы")
+		}
+		res.FreeSpinAvailable = !used
+	}
+	return res, nil
+}
+
+func (s *PaymentServer) SetWheelPrizes(ctx context.Context, req *paymentpb.SetWheelPrizesRequest) (*paymentpb.GetWheelPrizesResponse, error) {
+	if len(req.Prizes) == 0 || len(req.Prizes) > maxWheelPrizes {
+		return nil, status.Errorf(codes.InvalidArgument, "нужно от 1 до %d призов", maxWheelPrizes)
+	}
+
+	prizes := make([]domain.WheelPrize, 0, len(req.Prizes))
+	for i, p := range req.Prizes {
+		if err := validatePrize(p); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "приз %d: %v", i+1, err)
+		}
+		prizes = append(prizes, domain.WheelPrize{
+			Type:      p.PrizeType,
+			Value:     int(p.PrizeValue),
+			Weight:    int(p.Weight),
+			Label:     p.Label,
+			UpdatedBy: req.ActorId,
+		})
+	}
+
+	if err := s.repo.ReplaceWheelPrizes(ctx, prizes); err != nil {
+		return nil, status.Error(codes.Internal, "Не удалось сохранить призы")
+	}
+	log.Printf("[WHEEL] prize table replaced by %s: %d prizes", req.ActorId, len(prizes))
+	return wheelPrizesInfo(prizes, s.spinCost), nil
+}
+
+func validatePrize(p *paymentpb.WheelPrize) error {
+	if p.Weight <= 0 {
+		return errors.New("вес должен быть больше нуля")
+	}
+	switch p.PrizeType {
+	case domain.PrizeBalance, domain.PrizeSlot, domain.PrizeAvatar:
+		if p.PrizeValue <= 0 {
+			return errors.New("значение приза должно быть больше нуля")
+		}
+	case domain.PrizeEmpty:
+		if p.PrizeValue != 0 {
+			return errors.New("у пустого сектора нет значения")
+		}
+	default:
+		return errors.New("неизвестный тип приза")
+	}
+	return nil
+}
+
+func wheelPrizesInfo(prizes []domain.WheelPrize, spinCost int) *paymentpb.GetWheelPrizesResponse {
+	total := 0
+	for _, p := range prizes {
+		total += p.Weight
+	}
+
+	res := &paymentpb.GetWheelPrizesResponse{
+		Prizes:   make([]*paymentpb.WheelPrize, 0, len(prizes)),
+		SpinCost: int32(spinCost),
+	}
+	for _, p := range prizes {
+		info := &paymentpb.WheelPrize{
+			Id:         uint64(p.ID),
+			PrizeType:  p.Type,
+			PrizeValue: int32(p.Value),
+			Weight:     int32(p.Weight),
+			Label:      p.Label,
+		}
+		if total > 0 {
+			info.Chance = float64(p.Weight) * 100 / float64(total)
+		}
+		res.Prizes = append(res.Prizes, info)
+	}
+	return res
+}
+
+func (s *PaymentServer) ListWheelSpins(ctx context.Context, req *paymentpb.ListWheelSpinsRequest) (*paymentpb.ListWheelSpinsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = spinsDefaultLimit
+	}
+	if limit > spinsMaxLimit {
+		limit = spinsMaxLimit
+	}
+	offset := int(req.Offset)
+	if offset < 0 {
+		offset = 0
+	}
+
+	spins, total, err := s.repo.ListSpins(ctx, req.UserId, limit, offset)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list spins")
+	}
+	res := &paymentpb.ListWheelSpinsResponse{
+		Spins:      make([]*paymentpb.WheelSpin, 0, len(spins)),
+		TotalCount: total,
+	}
+	for _, sp := range spins {
+		res.Spins = append(res.Spins, &paymentpb.WheelSpin{
+			Id:         sp.ID.String(),
+			UserId:     sp.UserID,
+			Free:       sp.Free,
+			Cost:       int32(sp.Cost),
+			PrizeType:  sp.PrizeType,
+			PrizeValue: int32(sp.PrizeValue),
+			Status:     sp.Status,
+			Error:      sp.Error,
+			CreatedAt:  sp.CreatedAt.Unix(),
+		})
+	}
+	return res, nil
+}
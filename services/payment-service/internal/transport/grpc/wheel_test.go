@@ -0,0 +1,40 @@
+package grpc_server
+
+import (
+	"testing"
+
+	"payment-service/internal/domain"
+	paymentpb "payment-service/pkg/paymentpb/proto/payment"
+)
+
+func TestValidatePrize(t *testing.T) {
+	tests := []struct {
+		name    string
+		prize   *paymentpb.WheelPrize
+		wantErr bool
+	}{
+		{name: "balance", prize: &paymentpb.WheelPrize{PrizeType: domain.PrizeBalance, PrizeValue: 50, Weight: 10}},
+		{name: "slot", prize: &paymentpb.WheelPrize{PrizeType: domain.PrizeSlot, PrizeValue: 1, Weight: 1}},
+		{name: "avatar", prize: &paymentpb.WheelPrize{PrizeType: domain.PrizeAvatar, PrizeValue: 7, Weight: 1}},
+		{name: "empty", prize: &paymentpb.WheelPrize{PrizeType: domain.PrizeEmpty, Weight: 50}},
+
+		{name: "zero weight", prize: &paymentpb.WheelPrize{PrizeType: domain.PrizeBalance, PrizeValue: 50}, wantErr: true},
+		{name: "negative weight", prize: &paymentpb.WheelPrize{PrizeType: domain.PrizeEmpty, Weight: -1}, wantErr: true},
+		{name: "balance without value", prize: &paymentpb.WheelPrize{PrizeType: domain.PrizeBalance, Weight: 1}, wantErr: true},
+		{name: "negative balance", prize: &paymentpb.WheelPrize{PrizeType: domain.PrizeBalance, PrizeValue: -50, Weight: 1}, wantErr: true},
+		{name: "slot without value", prize: &paymentpb.WheelPrize{PrizeType: domain.PrizeSlot, Weight: 1}, wantErr: true},
+		{name: "avatar without id", prize: &paymentpb.WheelPrize{PrizeType: domain.PrizeAvatar, Weight: 1}, wantErr: true},
+		{name: "empty with value", prize: &paymentpb.WheelPrize{PrizeType: domain.PrizeEmpty, PrizeValue: 1, Weight: 1}, wantErr: true},
+		{name: "unknown type", prize: &paymentpb.WheelPrize{PrizeType: "COURSE", PrizeValue: 1, Weight: 1}, wantErr: true},
+		{name: "lowercase type", prize: &paymentpb.WheelPrize{PrizeType: "balance", PrizeValue: 1, Weight: 1}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validatePrize(tt.prize)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validatePrize() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -19,9 +19,18 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaymentService_GetPlans_FullMethodName     = "/payment.PaymentService/GetPlans"
-	PaymentService_RedeemPromo_FullMethodName  = "/payment.PaymentService/RedeemPromo"
-	PaymentService_PurchaseItem_FullMethodName = "/payment.PaymentService/PurchaseItem"
+	PaymentService_GetPlans_FullMethodName       = "/payment.PaymentService/GetPlans"
+	PaymentService_RedeemPromo_FullMethodName    = "/payment.PaymentService/RedeemPromo"
+	PaymentService_PurchaseItem_FullMethodName   = "/payment.PaymentService/PurchaseItem"
+	PaymentService_GetCatalog_FullMethodName     = "/payment.PaymentService/GetCatalog"
+	PaymentService_SpinWheel_FullMethodName      = "/payment.PaymentService/SpinWheel"
+	PaymentService_GetWheelPrizes_FullMethodName = "/payment.PaymentService/GetWheelPrizes"
+	PaymentService_SetWheelPrizes_FullMethodName = "/payment.PaymentService/SetWheelPrizes"
+	PaymentService_ListWheelSpins_FullMethodName = "/payment.PaymentService/ListWheelSpins"
+	PaymentService_GetOrder_FullMethodName       = "/payment.PaymentService/GetOrder"
+	PaymentService_ListOrders_FullMethodName     = "/payment.PaymentService/ListOrders"
+	PaymentService_DeleteUserData_FullMethodName = "/payment.PaymentService/DeleteUserData"
+	PaymentService_ExportUserData_FullMethodName = "/payment.PaymentService/ExportUserData"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -32,7 +41,25 @@ type PaymentServiceClient interface {
 	GetPlans(ctx context.Context, in *GetPlansRequest, opts ...grpc.CallOption) (*GetPlansResponse, error)
 	// Для страницы "Активация промокода"
 	RedeemPromo(ctx context.Context, in *RedeemPromoRequest, opts ...grpc.CallOption) (*RedeemPromoResponse, error)
+	// RedeemPromo, PurchaseItem и SpinWheel с idempotency_key выполняются один раз:
+	// повтор с тем же ключом получает ответ первого вызова
 	PurchaseItem(ctx context.Context, in *PurchaseItemRequest, opts ...grpc.CallOption) (*PurchaseItemResponse, error)
+	// Каталог магазина: товары, продающиеся сейчас, с ценами и скидками
+	GetCatalog(ctx context.Context, in *GetCatalogRequest, opts ...grpc.CallOption) (*GetCatalogResponse, error)
+	// Колесо фортуны: раз в сутки (UTC) бесплатно, дальше за снежинки.
+	// Каждое вращение с результатом сохраняется для аудита
+	SpinWheel(ctx context.Context, in *SpinWheelRequest, opts ...grpc.CallOption) (*SpinWheelResponse, error)
+	// Таблица призов с шансами. Админ (право shop:manage) заменяет ее целиком
+	GetWheelPrizes(ctx context.Context, in *GetWheelPrizesRequest, opts ...grpc.CallOption) (*GetWheelPrizesResponse, error)
+	SetWheelPrizes(ctx context.Context, in *SetWheelPrizesRequest, opts ...grpc.CallOption) (*GetWheelPrizesResponse, error)
+	ListWheelSpins(ctx context.Context, in *ListWheelSpinsRequest, opts ...grpc.CallOption) (*ListWheelSpinsResponse, error)
+	// Заказы: каждая покупка проходит pending -> debited -> granted,
+	// при ошибке выдачи — refunded, при нехватке снежинок — failed
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*Order, error)
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
+	// Удаление аккаунта и выгрузка данных пользователя (вызывает Auth Service)
+	DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error)
+	ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -73,6 +100,96 @@ func (c *paymentServiceClient) PurchaseItem(ctx context.Context, in *PurchaseIte
 	return out, nil
 }
 
+func (c *paymentServiceClient) GetCatalog(ctx context.Context, in *GetCatalogRequest, opts ...grpc.CallOption) (*GetCatalogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCatalogResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetCatalog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) SpinWheel(ctx context.Context, in *SpinWheelRequest, opts ...grpc.CallOption) (*SpinWheelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SpinWheelResponse)
+	err := c.cc.Invoke(ctx, PaymentService_SpinWheel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetWheelPrizes(ctx context.Context, in *GetWheelPrizesRequest, opts ...grpc.CallOption) (*GetWheelPrizesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWheelPrizesResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetWheelPrizes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) SetWheelPrizes(ctx context.Context, in *SetWheelPrizesRequest, opts ...grpc.CallOption) (*GetWheelPrizesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWheelPrizesResponse)
+	err := c.cc.Invoke(ctx, PaymentService_SetWheelPrizes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListWheelSpins(ctx context.Context, in *ListWheelSpinsRequest, opts ...grpc.CallOption) (*ListWheelSpinsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWheelSpinsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListWheelSpins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*Order, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Order)
+	err := c.cc.Invoke(ctx, PaymentService_GetOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) DeleteUserData(ctx context.Context, in *DeleteUserDataRequest, opts ...grpc.CallOption) (*DeleteUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteUserDataResponse)
+	err := c.cc.Invoke(ctx, PaymentService_DeleteUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ExportUserData(ctx context.Context, in *ExportUserDataRequest, opts ...grpc.CallOption) (*ExportUserDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportUserDataResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ExportUserData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
@@ -81,7 +198,25 @@ type PaymentServiceServer interface {
 	GetPlans(context.Context, *GetPlansRequest) (*GetPlansResponse, error)
 	// Для страницы "Активация промокода"
 	RedeemPromo(context.Context, *RedeemPromoRequest) (*RedeemPromoResponse, error)
+	// RedeemPromo, PurchaseItem и SpinWheel с idempotency_key выполняются один раз:
+	// повтор с тем же ключом получает ответ первого вызова
 	PurchaseItem(context.Context, *PurchaseItemRequest) (*PurchaseItemResponse, error)
+	// Каталог магазина: товары, продающиеся сейчас, с ценами и скидками
+	GetCatalog(context.Context, *GetCatalogRequest) (*GetCatalogResponse, error)
+	// Колесо фортуны: раз в сутки (UTC) бесплатно, дальше за снежинки.
+	// Каждое вращение с результатом сохраняется для аудита
+	SpinWheel(context.Context, *SpinWheelRequest) (*SpinWheelResponse, error)
+	// Таблица призов с шансами. Админ (право shop:manage) заменяет ее целиком
+	GetWheelPrizes(context.Context, *GetWheelPrizesRequest) (*GetWheelPrizesResponse, error)
+	SetWheelPrizes(context.Context, *SetWheelPrizesRequest) (*GetWheelPrizesResponse, error)
+	ListWheelSpins(context.Context, *ListWheelSpinsRequest) (*ListWheelSpinsResponse, error)
+	// Заказы: каждая покупка проходит pending -> debited -> granted,
+	// при ошибке выдачи — refunded, при нехватке снежинок — failed
+	GetOrder(context.Context, *GetOrderRequest) (*Order, error)
+	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
+	// Удаление аккаунта и выгрузка данных пользователя (вызывает Auth Service)
+	DeleteUserData(context.Context, *DeleteUserDataRequest) (*DeleteUserDataResponse, error)
+	ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -101,6 +236,33 @@ func (UnimplementedPaymentServiceServer) RedeemPromo(context.Context, *RedeemPro
 func (UnimplementedPaymentServiceServer) PurchaseItem(context.Context, *PurchaseItemRequest) (*PurchaseItemResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurchaseItem not implemented")
 }
+func (UnimplementedPaymentServiceServer) GetCatalog(context.Context, *GetCatalogRequest) (*GetCatalogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalog not implemented")
+}
+func (UnimplementedPaymentServiceServer) SpinWheel(context.Context, *SpinWheelRequest) (*SpinWheelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpinWheel not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetWheelPrizes(context.Context, *GetWheelPrizesRequest) (*GetWheelPrizesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWheelPrizes not implemented")
+}
+func (UnimplementedPaymentServiceServer) SetWheelPrizes(context.Context, *SetWheelPrizesRequest) (*GetWheelPrizesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWheelPrizes not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListWheelSpins(context.Context, *ListWheelSpinsRequest) (*ListWheelSpinsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWheelSpins not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetOrder(context.Context, *GetOrderRequest) (*Order, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedPaymentServiceServer) DeleteUserData(context.Context, *DeleteUserDataRequest) (*DeleteUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUserData not implemented")
+}
+func (UnimplementedPaymentServiceServer) ExportUserData(context.Context, *ExportUserDataRequest) (*ExportUserDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUserData not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -176,6 +338,168 @@ func _PaymentService_PurchaseItem_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_GetCatalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetCatalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetCatalog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetCatalog(ctx, req.(*GetCatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_SpinWheel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpinWheelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).SpinWheel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_SpinWheel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).SpinWheel(ctx, req.(*SpinWheelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetWheelPrizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWheelPrizesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetWheelPrizes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetWheelPrizes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetWheelPrizes(ctx, req.(*GetWheelPrizesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_SetWheelPrizes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWheelPrizesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).SetWheelPrizes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_SetWheelPrizes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).SetWheelPrizes(ctx, req.(*SetWheelPrizesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListWheelSpins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWheelSpinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListWheelSpins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListWheelSpins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListWheelSpins(ctx, req.(*ListWheelSpinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_DeleteUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).DeleteUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_DeleteUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).DeleteUserData(ctx, req.(*DeleteUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ExportUserData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportUserDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ExportUserData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ExportUserData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ExportUserData(ctx, req.(*ExportUserDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -195,6 +519,42 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PurchaseItem",
 			Handler:    _PaymentService_PurchaseItem_Handler,
 		},
+		{
+			MethodName: "GetCatalog",
+			Handler:    _PaymentService_GetCatalog_Handler,
+		},
+		{
+			MethodName: "SpinWheel",
+			Handler:    _PaymentService_SpinWheel_Handler,
+		},
+		{
+			MethodName: "GetWheelPrizes",
+			Handler:    _PaymentService_GetWheelPrizes_Handler,
+		},
+		{
+			MethodName: "SetWheelPrizes",
+			Handler:    _PaymentService_SetWheelPrizes_Handler,
+		},
+		{
+			MethodName: "ListWheelSpins",
+			Handler:    _PaymentService_ListWheelSpins_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _PaymentService_GetOrder_Handler,
+		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _PaymentService_ListOrders_Handler,
+		},
+		{
+			MethodName: "DeleteUserData",
+			Handler:    _PaymentService_DeleteUserData_Handler,
+		},
+		{
+			MethodName: "ExportUserData",
+			Handler:    _PaymentService_ExportUserData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment.proto",
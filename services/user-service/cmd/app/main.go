@@ -11,9 +11,11 @@ import (
 	"github.com/waste3d/gameplatform-api/services/user-service/internal/domain"
 	"github.com/waste3d/gameplatform-api/services/user-service/internal/infrastructure/repository"
 	grpc_server "github.com/waste3d/gameplatform-api/services/user-service/internal/transport/grpc"
+	authpb "github.com/waste3d/gameplatform-api/services/user-service/pkg/authpb/proto/auth"
 	userpb "github.com/waste3d/gameplatform-api/services/user-service/pkg/userpb/proto/user"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 )
@@ -36,9 +38,15 @@ func main() {
 
 	// 3. Миграции (создаст таблицу profiles)
 	log.Println("Running migrations...")
-	if err := db.AutoMigrate(&domain.Profile{}, &domain.UserCourse{}, &domain.CompletedLesson{}, &domain.UnlockedAvatar{}); err != nil {
+	hadEmailVerified := db.Migrator().HasColumn(&domain.Profile{}, "EmailVerifiedAt")
+	if err := db.AutoMigrate(&domain.Profile{}, &domain.UserCourse{}, &domain.CompletedLesson{}, &domain.UnlockedAvatar{}, &domain.CourseSlotGrant{}, &domain.BalanceTransaction{}, &domain.LedgerEntry{}); err != nil {
 		log.Fatalf("Failed to migrate DB: %v", err)
 	}
+	// Профили, созданные до появления подтверждения почты, считаем подтвержденными,
+	// иначе у всех старых пользователей пропадут покупки
+	if !hadEmailVerified {
+		db.Exec("UPDATE profiles SET email_verified_at = created_at WHERE email_verified_at IS NULL")
+	}
 
 	rdb := redis.NewClient(&redis.Options{
 		Addr: cfg.RedisAddr,
@@ -47,9 +55,25 @@ func main() {
 		log.Fatal("Failed to connect to Redis:", err)
 	}
 
+	// Без Auth понижение тарифа не отключит лишние устройства — лучше не стартовать, чем молча терять это
+	if cfg.AuthSvcUrl == "" {
+		log.Fatal("AUTH_SVC_URL is not set")
+	}
+	authConn, err := grpc.NewClient(cfg.AuthSvcUrl, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("Failed to connect to Auth Service: %v", err)
+	}
+	defer authConn.Close()
+
 	// 4. Инициализация слоев
 	profileRepo := repository.NewProfileRepository(db, rdb)
-	userServer := grpc_server.NewUserServer(profileRepo)
+	// Балансы, накопленные до журнала операций, попадают в него одной операцией opening_balance
+	if n, err := profileRepo.OpenLedger(context.Background()); err != nil {
+		log.Fatalf("Failed to open balance ledger: %v", err)
+	} else if n > 0 {
+		log.Printf("Opened balance ledger for %d profiles", n)
+	}
+	userServer := grpc_server.NewUserServer(profileRepo, authpb.NewAuthServiceClient(authConn))
 
 	// 5. Запуск gRPC сервера
 	lis, err := net.Listen("tcp", cfg.GRPCPort)
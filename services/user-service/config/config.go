@@ -10,6 +10,9 @@ type Config struct {
 	DBName     string `mapstructure:"DB_NAME"`
 	GRPCPort   string `mapstructure:"GRPC_PORT"`
 	RedisAddr  string `mapstructure:"REDIS_ADDR"`
+	// Auth Service — отключает лишние устройства, когда лимит тарифа понижается.
+	// Обязателен; локально — localhost:50051 (см. app.env)
+	AuthSvcUrl string `mapstructure:"AUTH_SVC_URL"`
 }
 
 func LoadConfig(path string) (config Config, err error) {
@@ -25,6 +28,7 @@ func LoadConfig(path string) (config Config, err error) {
 	viper.BindEnv("DB_NAME")
 	viper.BindEnv("GRPC_PORT")
 	viper.BindEnv("REDIS_ADDR")
+	viper.BindEnv("AUTH_SVC_URL")
 
 	err = viper.ReadInConfig()
 	if err != nil {
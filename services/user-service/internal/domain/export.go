@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DataExport — все, что сервис хранит о пользователе, в виде для выгрузки по его запросу
+type DataExport struct {
+	Profile          ProfileExport   `json:"profile"`
+	Courses          []CourseExport  `json:"courses"`
+	CompletedLessons []LessonExport  `json:"completed_lessons"`
+	UnlockedAvatars  []int           `json:"unlocked_avatars"`
+	BalanceHistory   []BalanceExport `json:"balance_history"`
+}
+
+type ProfileExport struct {
+	ID                 uuid.UUID  `json:"id"`
+	Email              string     `json:"email"`
+	Username           string     `json:"username"`
+	AvatarID           int        `json:"avatar_id"`
+	EmailVerifiedAt    *time.Time `json:"email_verified_at"`
+	Locale             string     `json:"locale"`
+	SubscriptionStatus string     `json:"subscription_status"`
+	SubscriptionEndsAt time.Time  `json:"subscription_ends_at"`
+	CourseLimit        int        `json:"course_limit"`
+	DeviceLimit        int        `json:"device_limit"`
+	HasTgAccess        bool       `json:"has_tg_access"`
+	Streak             int        `json:"streak"`
+	LastStreakAt       time.Time  `json:"last_streak_at"`
+	Balance            int        `json:"balance"`
+	CompletedCount     int        `json:"completed_count"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+}
+
+type CourseExport struct {
+	CourseID        string    `json:"course_id"`
+	Title           string    `json:"title"`
+	ProgressPercent int32     `json:"progress_percent"`
+	Status          string    `json:"status"`
+	LastAccessedAt  time.Time `json:"last_accessed_at"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+type BalanceExport struct {
+	Amount       int       `json:"amount"`
+	BalanceAfter int       `json:"balance_after"`
+	Reason       string    `json:"reason"`
+	ReferenceID  string    `json:"reference_id"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+type LessonExport struct {
+	CourseID    string    `json:"course_id"`
+	LessonID    string    `json:"lesson_id"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+func NewDataExport(p *Profile, courses []UserCourse, lessons []CompletedLesson, avatars []UnlockedAvatar, transactions []BalanceTransaction) *DataExport {
+	export := &DataExport{
+		Profile: ProfileExport{
+			ID:                 p.ID,
+			Email:              p.Email,
+			Username:           p.Username,
+			AvatarID:           p.AvatarID,
+			EmailVerifiedAt:    p.EmailVerifiedAt,
+			Locale:             p.Locale,
+			SubscriptionStatus: p.SubscriptionStatus,
+			SubscriptionEndsAt: p.SubscriptionEndsAt,
+			CourseLimit:        p.CourseLimit,
+			DeviceLimit:        p.DeviceLimit,
+			HasTgAccess:        p.HasTgAccess,
+			Streak:             p.Streak,
+			LastStreakAt:       p.LastStreakAt,
+			Balance:            p.Balance,
+			CompletedCount:     p.CompletedCount,
+			CreatedAt:          p.CreatedAt,
+			UpdatedAt:          p.UpdatedAt,
+		},
+		Courses:          make([]CourseExport, 0, len(courses)),
+		CompletedLessons: make([]LessonExport, 0, len(lessons)),
+		UnlockedAvatars:  make([]int, 0, len(avatars)),
+		BalanceHistory:   make([]BalanceExport, 0, len(transactions)),
+	}
+	for _, c := range courses {
+		export.Courses = append(export.Courses, CourseExport{
+			CourseID:        c.CourseID,
+			Title:           c.Title,
+			ProgressPercent: c.ProgressPercent,
+			Status:          c.Status,
+			LastAccessedAt:  c.LastAccessedAt,
+			CreatedAt:       c.CreatedAt,
+		})
+	}
+	for _, l := range lessons {
+		export.CompletedLessons = append(export.CompletedLessons, LessonExport{
+			CourseID:    l.CourseID,
+			LessonID:    l.LessonID,
+			CompletedAt: l.CreatedAt,
+		})
+	}
+	for _, a := range avatars {
+		export.UnlockedAvatars = append(export.UnlockedAvatars, a.AvatarID)
+	}
+	for _, t := range transactions {
+		export.BalanceHistory = append(export.BalanceHistory, BalanceExport{
+			Amount:       t.Amount,
+			BalanceAfter: t.BalanceAfter,
+			Reason:       t.Reason,
+			ReferenceID:  t.ReferenceID,
+			CreatedAt:    t.CreatedAt,
+		})
+	}
+	return export
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrInsufficientFunds = errors.New("insufficient funds")
+	ErrUnknownReason     = errors.New("unknown balance change reason")
+	// Ключ идемпотентности уже использован для операции с другой суммой или причиной
+	ErrIdempotencyKeyReused = errors.New("idempotency key reused with different parameters")
+)
+
+// Причины движения снежинок
+const (
+	ReasonDailyBonus       = "daily_bonus"
+	ReasonCourseCompletion = "course_completion"
+	ReasonPurchase         = "purchase"
+	ReasonRefund           = "refund"
+	ReasonAdminGrant       = "admin_grant"
+	// Платное вращение колеса и выигрыш в нем
+	ReasonWheelSpin  = "wheel_spin"
+	ReasonWheelPrize = "wheel_prize"
+	// Баланс, накопленный до появления журнала
+	ReasonOpeningBalance = "opening_balance"
+)
+
+// Системные счета — вторая сторона каждой проводки: сумма проводок по операции всегда ноль
+const (
+	AccountRewards = "system:rewards"
+	AccountShop    = "system:shop"
+	AccountAdmin   = "system:admin"
+	AccountOpening = "system:opening"
+	AccountWheel   = "system:wheel"
+)
+
+// ReasonAccounts — с какого системного счета приходят (или куда уходят) снежинки по каждой причине
+var ReasonAccounts = map[string]string{
+	ReasonDailyBonus:       AccountRewards,
+	ReasonCourseCompletion: AccountRewards,
+	ReasonPurchase:         AccountShop,
+	ReasonRefund:           AccountShop,
+	ReasonAdminGrant:       AccountAdmin,
+	ReasonWheelSpin:        AccountWheel,
+	ReasonWheelPrize:       AccountWheel,
+	ReasonOpeningBalance:   AccountOpening,
+}
+
+func UserAccount(userID uuid.UUID) string {
+	return "user:" + userID.String()
+}
+
+// DeletedUserAccount — счет удаленного пользователя: проводки остаются, но по псевдониму
+// нельзя понять, чьи они
+func DeletedUserAccount(pseudonym uuid.UUID) string {
+	return "deleted:" + pseudonym.String()
+}
+
+// BalanceChange — запрос на движение снежинок. IdempotencyKey уникален в пределах пользователя:
+// повторный запрос с тем же ключом возвращает уже проведенную операцию.
+type BalanceChange struct {
+	UserID         uuid.UUID
+	Amount         int // Положительное — начисление, отрицательное — списание
+	Reason         string
+	ReferenceID    string // Курс, товар, покупка — к чему относится операция
+	IdempotencyKey string
+}
+
+// BalanceTransaction — операция по балансу пользователя (то, что видно в истории).
+// Profile.Balance всегда равен BalanceAfter последней операции и меняется в той же транзакции БД.
+type BalanceTransaction struct {
+	ID             uint      `gorm:"primaryKey"`
+	UserID         uuid.UUID `gorm:"type:uuid;not null;index;uniqueIndex:idx_balance_tx_idempotency"`
+	Amount         int       `gorm:"not null"`
+	BalanceAfter   int       `gorm:"not null"`
+	Reason         string    `gorm:"size:32;not null"`
+	ReferenceID    string
+	IdempotencyKey *string `gorm:"uniqueIndex:idx_balance_tx_idempotency"`
+	// Две проводки: счет пользователя и системный счет причины
+	Entries   []LedgerEntry `gorm:"foreignKey:TransactionID;constraint:OnDelete:CASCADE;"`
+	CreatedAt time.Time
+}
+
+// LedgerEntry — проводка по одному счету. Журнал только пополняется.
+type LedgerEntry struct {
+	ID            uint   `gorm:"primaryKey"`
+	TransactionID uint   `gorm:"not null;index"`
+	Account       string `gorm:"size:64;not null;index"`
+	Amount        int    `gorm:"not null"`
+	CreatedAt     time.Time
+}
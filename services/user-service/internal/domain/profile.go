@@ -6,11 +6,18 @@ import (
 	"github.com/google/uuid"
 )
 
+// Языки, на которых есть письма и интерфейс
+var SupportedLocales = map[string]bool{"ru": true, "en": true}
+
 type Profile struct {
 	ID       uuid.UUID `gorm:"type:uuid;primaryKey"`
 	Email    string    `gorm:"uniqueIndex"`
 	Username string
 	AvatarID int `gorm:"default:1"`
+	// Когда пользователь подтвердил почту (ставит Auth). nil — не подтверждена
+	EmailVerifiedAt *time.Time
+	// Язык писем (ru, en)
+	Locale string `gorm:"default:'ru'"`
 
 	SubscriptionStatus string `gorm:"default:'Обычный'"`
 	CourseLimit        int    `gorm:"default:0"`
@@ -21,6 +28,10 @@ type Profile struct {
 	Streak       int `gorm:"default:0"`
 	LastStreakAt time.Time
 
+	// Бан ставит Auth (SetBanStatus). BannedUntil nil при Banned — бессрочный
+	Banned      bool `gorm:"default:false"`
+	BannedUntil *time.Time
+
 	// === НОВЫЕ ПОЛЯ ===
 	Balance        int `gorm:"default:0"` // Снежинки
 	CompletedCount int `gorm:"default:0"` // Статистика для лидерборда
@@ -32,6 +43,19 @@ type Profile struct {
 	UpdatedAt time.Time
 }
 
+// IsBanned учитывает срок: временный бан заканчивается сам, без вызова от Auth
+func (p *Profile) IsBanned(now time.Time) bool {
+	return p.Banned && (p.BannedUntil == nil || p.BannedUntil.After(now))
+}
+
+// CourseSlotGrant — уже начисленные слоты по ключу идемпотентности, чтобы повтор запроса не добавил их дважды
+type CourseSlotGrant struct {
+	UserID         uuid.UUID `gorm:"type:uuid;primaryKey"`
+	IdempotencyKey string    `gorm:"primaryKey"`
+	Count          int
+	CreatedAt      time.Time
+}
+
 // Таблица для хранения купленных/выигранных аватарок
 type UnlockedAvatar struct {
 	UserID   uuid.UUID `gorm:"type:uuid;primaryKey;index"`
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/waste3d/gameplatform-api/services/user-service/internal/domain"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// Ключ идемпотентности операции, переносящей старый баланс в журнал
+const openingBalanceKey = "opening_balance"
+
+// ChangeBalance проводит операцию по балансу. Профиль блокируется до конца транзакции
+// (SELECT ... FOR UPDATE), поэтому параллельные списания не уведут баланс в минус.
+// Повтор с тем же ключом идемпотентности возвращает уже проведенную операцию и баланс не меняет.
+func (r *ProfileRepository) ChangeBalance(ctx context.Context, ch domain.BalanceChange) (*domain.BalanceTransaction, error) {
+	account, ok := domain.ReasonAccounts[ch.Reason]
+	if !ok {
+		return nil, domain.ErrUnknownReason
+	}
+
+	var result domain.BalanceTransaction
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var p domain.Profile
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id", "balance").
+			Where("id = ?", ch.UserID).
+			First(&p).Error; err != nil {
+			return err
+		}
+
+		if ch.IdempotencyKey != "" {
+			err := tx.Where("user_id = ? AND idempotency_key = ?", ch.UserID, ch.IdempotencyKey).First(&result).Error
+			if err == nil {
+				if result.Amount != ch.Amount || result.Reason != ch.Reason {
+					return domain.ErrIdempotencyKeyReused
+				}
+				return nil
+			}
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
+			}
+		}
+
+		if p.Balance+ch.Amount < 0 {
+			return domain.ErrInsufficientFunds
+		}
+		result = newBalanceTransaction(ch, account, p.Balance+ch.Amount)
+		if err := tx.Create(&result).Error; err != nil {
+			return err
+		}
+		return tx.Model(&domain.Profile{}).Where("id = ?", ch.UserID).Update("balance", result.BalanceAfter).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	r.invalidateCache(ctx, ch.UserID.String())
+	return &result, nil
+}
+
+func newBalanceTransaction(ch domain.BalanceChange, account string, balanceAfter int) domain.BalanceTransaction {
+	t := domain.BalanceTransaction{
+		UserID:       ch.UserID,
+		Amount:       ch.Amount,
+		BalanceAfter: balanceAfter,
+		Reason:       ch.Reason,
+		ReferenceID:  ch.ReferenceID,
+		Entries: []domain.LedgerEntry{
+			{Account: domain.UserAccount(ch.UserID), Amount: ch.Amount},
+			{Account: account, Amount: -ch.Amount},
+		},
+	}
+	if ch.IdempotencyKey != "" {
+		key := ch.IdempotencyKey
+		t.IdempotencyKey = &key
+	}
+	return t
+}
+
+// BalanceHistory — операции пользователя, новые первыми. beforeID > 0 — страница старше этой операции.
+func (r *ProfileRepository) BalanceHistory(ctx context.Context, userID uuid.UUID, limit int, beforeID uint) ([]domain.BalanceTransaction, error) {
+	q := r.db.WithContext(ctx).Where("user_id = ?", userID)
+	if beforeID > 0 {
+		q = q.Where("id < ?", beforeID)
+	}
+	var items []domain.BalanceTransaction
+	err := q.Order("id desc").Limit(limit).Find(&items).Error
+	return items, err
+}
+
+// OpenLedger переносит в журнал балансы, накопленные до его появления: у каждого профиля
+// с ненулевым балансом и без операций появляется операция opening_balance. Повторный запуск ничего не меняет.
+func (r *ProfileRepository) OpenLedger(ctx context.Context) (int, error) {
+	var profiles []domain.Profile
+	err := r.db.WithContext(ctx).
+		Select("id", "balance").
+		Where("balance <> 0 AND NOT EXISTS (SELECT 1 FROM balance_transactions t WHERE t.user_id = profiles.id)").
+		Find(&profiles).Error
+	if err != nil {
+		return 0, err
+	}
+
+	for i, p := range profiles {
+		t := newBalanceTransaction(domain.BalanceChange{
+			UserID:         p.ID,
+			Amount:         p.Balance,
+			Reason:         domain.ReasonOpeningBalance,
+			IdempotencyKey: openingBalanceKey,
+		}, domain.AccountOpening, p.Balance)
+		if err := r.db.WithContext(ctx).Create(&t).Error; err != nil {
+			return i, err
+		}
+	}
+	return len(profiles), nil
+}
+
+// anonymizeLedger отвязывает операции удаленного пользователя от его ID. Удалять их нельзя:
+// без проводок пользователя системные счета перестанут сходиться, а журнал — только пополняется.
+// Псевдоним свой у каждого удаленного, поэтому ключи идемпотентности не конфликтуют.
+func anonymizeLedger(tx *gorm.DB, userID uuid.UUID) error {
+	pseudonym := uuid.New()
+	if err := tx.Model(&domain.LedgerEntry{}).
+		Where("account = ?", domain.UserAccount(userID)).
+		Update("account", domain.DeletedUserAccount(pseudonym)).Error; err != nil {
+		return err
+	}
+	return tx.Model(&domain.BalanceTransaction{}).
+		Where("user_id = ?", userID).
+		Update("user_id", pseudonym).Error
+}
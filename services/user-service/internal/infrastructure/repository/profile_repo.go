@@ -2,8 +2,9 @@ package repository
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"time"
 
 	"github.com/waste3d/gameplatform-api/services/user-service/internal/domain"
@@ -11,6 +12,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type ProfileRepository struct {
@@ -59,15 +61,23 @@ func (r *ProfileRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.
 
 // services/user-service/internal/infrastructure/repository/profile_repo.go
 
-func (r *ProfileRepository) Create(ctx context.Context, profile *domain.Profile) error {
+// Create идемпотентен по ID: Auth повторяет вызов, пока не получит успех,
+// поэтому уже существующий профиль — не ошибка (created == false).
+func (r *ProfileRepository) Create(ctx context.Context, profile *domain.Profile) (bool, error) {
+	created := false
 	// Используем транзакцию, чтобы обе операции (создание профиля и аватарок)
 	// были выполнены успешно, либо ни одна из них.
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 		// 1. Создаем основной профиль пользователя
-		if err := tx.Create(profile).Error; err != nil {
+		res := tx.Clauses(clause.OnConflict{Columns: []clause.Column{{Name: "id"}}, DoNothing: true}).Create(profile)
+		if res.Error != nil {
 			// Если возникает ошибка (например, email уже занят), транзакция отменяется.
-			return err
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return nil
 		}
+		created = true
 
 		// 2. Создаем записи для 5 стандартных разблокированных аватарок
 		defaultAvatars := []domain.UnlockedAvatar{
@@ -86,7 +96,66 @@ func (r *ProfileRepository) Create(ctx context.Context, profile *domain.Profile)
 		// Если ошибок не было, транзакция автоматически коммитится.
 		return nil
 	})
+	return created, err
+}
+
+// ListIDs отдает ID профилей постранично по возрастанию (для сверки с Auth)
+func (r *ProfileRepository) ListIDs(ctx context.Context, after uuid.UUID, limit int) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	err := r.db.WithContext(ctx).Model(&domain.Profile{}).
+		Where("id > ?", after).
+		Order("id").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+// Delete удаляет профиль со всеми данными пользователя; операции по балансу обезличиваются
+func (r *ProfileRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, model := range []interface{}{&domain.CompletedLesson{}, &domain.UserCourse{}, &domain.UnlockedAvatar{}, &domain.CourseSlotGrant{}} {
+			if err := tx.Where("user_id = ?", id).Delete(model).Error; err != nil {
+				return err
+			}
+		}
+		if err := anonymizeLedger(tx, id); err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&domain.Profile{}).Error
+	})
+	if err == nil {
+		r.invalidateCache(ctx, id.String())
+	}
+	return err
 }
+
+// Export читает из БД в обход кеша: в выгрузку должно попасть то, что хранится на самом деле
+func (r *ProfileRepository) Export(ctx context.Context, id uuid.UUID) (*domain.DataExport, error) {
+	db := r.db.WithContext(ctx)
+
+	var profile domain.Profile
+	if err := db.Where("id = ?", id).First(&profile).Error; err != nil {
+		return nil, err
+	}
+	var courses []domain.UserCourse
+	if err := db.Where("user_id = ?", id).Order("created_at").Find(&courses).Error; err != nil {
+		return nil, err
+	}
+	var lessons []domain.CompletedLesson
+	if err := db.Where("user_id = ?", id).Order("created_at").Find(&lessons).Error; err != nil {
+		return nil, err
+	}
+	var avatars []domain.UnlockedAvatar
+	if err := db.Where("user_id = ?", id).Order("avatar_id").Find(&avatars).Error; err != nil {
+		return nil, err
+	}
+	var transactions []domain.BalanceTransaction
+	if err := db.Where("user_id = ?", id).Order("id").Find(&transactions).Error; err != nil {
+		return nil, err
+	}
+	return domain.NewDataExport(&profile, courses, lessons, avatars, transactions), nil
+}
+
 func (r *ProfileRepository) Update(ctx context.Context, profile *domain.Profile) error {
 	err := r.db.WithContext(ctx).Save(profile).Error
 	if err == nil {
@@ -103,6 +172,30 @@ func (r *ProfileRepository) UpdateEmail(ctx context.Context, id uuid.UUID, email
 	return err
 }
 
+func (r *ProfileRepository) MarkEmailVerified(ctx context.Context, id uuid.UUID) error {
+	err := r.db.WithContext(ctx).Model(&domain.Profile{}).
+		Where("id = ? AND email_verified_at IS NULL", id).
+		Update("email_verified_at", time.Now()).Error
+	if err == nil {
+		r.invalidateCache(ctx, id.String())
+	}
+	return err
+}
+
+func (r *ProfileRepository) SetBanStatus(ctx context.Context, id uuid.UUID, banned bool, until *time.Time) error {
+	err := r.db.WithContext(ctx).Model(&domain.Profile{}).Where("id = ?", id).
+		Updates(map[string]interface{}{"banned": banned, "banned_until": until}).Error
+	if err == nil {
+		r.invalidateCache(ctx, id.String())
+	}
+	return err
+}
+
+// notBanned — условие для рейтинга: забаненных в нем не показываем
+func notBanned(db *gorm.DB) *gorm.DB {
+	return db.Where("(NOT banned OR banned_until <= ?)", time.Now())
+}
+
 func (r *ProfileRepository) UpdateUsername(ctx context.Context, id uuid.UUID, username string) error {
 	err := r.db.WithContext(ctx).Model(&domain.Profile{}).Where("id = ?", id).Update("username", username).Error
 	if err == nil {
@@ -111,6 +204,14 @@ func (r *ProfileRepository) UpdateUsername(ctx context.Context, id uuid.UUID, us
 	return err
 }
 
+func (r *ProfileRepository) UpdateLocale(ctx context.Context, id uuid.UUID, locale string) error {
+	err := r.db.WithContext(ctx).Model(&domain.Profile{}).Where("id = ?", id).Update("locale", locale).Error
+	if err == nil {
+		r.invalidateCache(ctx, id.String())
+	}
+	return err
+}
+
 func (r *ProfileRepository) UpdateAvatar(ctx context.Context, id uuid.UUID, avatarID int) error {
 	err := r.db.WithContext(ctx).Model(&domain.Profile{}).Where("id = ?", id).Update("avatar_id", avatarID).Error
 	if err == nil {
@@ -258,38 +359,38 @@ func (r *ProfileRepository) CheckAndIncrementStreak(ctx context.Context, userID
 	return true, nil
 }
 
-func (r *ProfileRepository) AddCourseSlots(ctx context.Context, userID uuid.UUID, count int) error {
-	err := r.db.WithContext(ctx).Model(&domain.Profile{}).
-		Where("id = ?", userID).
-		Update("course_limit", gorm.Expr("course_limit + ?", count)).Error
-	if err == nil {
-		r.invalidateCache(ctx, userID.String())
-	}
-	return err
-}
-
-func (r *ProfileRepository) ChangeBalance(ctx context.Context, userID uuid.UUID, amount int) (int, error) {
-	var newBalance int
+// AddCourseSlots добавляет слоты для курсов. С ключом идемпотентности повтор ничего не меняет:
+// отметка о начислении пишется в той же транзакции, что и лимит
+func (r *ProfileRepository) AddCourseSlots(ctx context.Context, userID uuid.UUID, count int, idempotencyKey string) error {
 	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		var p domain.Profile
-		if err := tx.Select("balance").Where("id = ?", userID).First(&p).Error; err != nil {
-			return err
+		if idempotencyKey != "" {
+			res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&domain.CourseSlotGrant{
+				UserID:         userID,
+				IdempotencyKey: idempotencyKey,
+				Count:          count,
+			})
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				return nil // Уже начислено
+			}
 		}
-
-		if p.Balance+amount < 0 {
-			return fmt.Errorf("insufficient funds")
+		res := tx.Model(&domain.Profile{}).
+			Where("id = ?", userID).
+			Update("course_limit", gorm.Expr("course_limit + ?", count))
+		if res.Error != nil {
+			return res.Error
 		}
-
-		p.Balance += amount
-		newBalance = p.Balance
-
-		return tx.Model(&domain.Profile{}).Where("id = ?", userID).Update("balance", p.Balance).Error
+		if res.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+		return nil
 	})
-
 	if err == nil {
 		r.invalidateCache(ctx, userID.String())
 	}
-	return newBalance, err
+	return err
 }
 
 func (r *ProfileRepository) AddUnlockedAvatar(ctx context.Context, userID uuid.UUID, avatarID int) (bool, error) {
@@ -318,6 +419,7 @@ func (r *ProfileRepository) GetLeaderboard(ctx context.Context, limit int) ([]do
 	var users []domain.Profile
 	// Сортируем по стрику и кол-ву пройденных курсов
 	err := r.db.WithContext(ctx).
+		Scopes(notBanned).
 		Order("streak desc, completed_count desc").
 		Limit(limit).
 		Find(&users).Error
@@ -348,7 +450,7 @@ func (r *ProfileRepository) GetUserCourseStatus(ctx context.Context, userID uuid
 func (r *ProfileRepository) GetUserRank(ctx context.Context, userID uuid.UUID) (int64, error) {
 	var rank int64
 
-	subQuery := r.db.WithContext(ctx).Model(&domain.Profile{}).Select("id, ROW_NUMBER() over (order by streak desc, completed_count desc, balance desc) as rank")
+	subQuery := r.db.WithContext(ctx).Model(&domain.Profile{}).Scopes(notBanned).Select("id, ROW_NUMBER() over (order by streak desc, completed_count desc, balance desc) as rank")
 
 	err := r.db.WithContext(ctx).
 		Table("(?) as ranked_users", subQuery).
@@ -356,6 +458,10 @@ func (r *ProfileRepository) GetUserRank(ctx context.Context, userID uuid.UUID) (
 		Where("id = ?", userID).
 		Row().Scan(&rank)
 
+	// Забаненного нет в рейтинге — места у него нет
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
 	if err != nil {
 		return 0, err
 	}
@@ -2,26 +2,32 @@ package grpc_server
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/waste3d/gameplatform-api/services/user-service/internal/domain"
 	"github.com/waste3d/gameplatform-api/services/user-service/internal/infrastructure/repository"
 
+	authpb "github.com/waste3d/gameplatform-api/services/user-service/pkg/authpb/proto/auth"
 	userpb "github.com/waste3d/gameplatform-api/services/user-service/pkg/userpb/proto/user"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
 )
 
 type UserServer struct {
 	userpb.UnimplementedUserServiceServer
-	repo *repository.ProfileRepository
+	repo       *repository.ProfileRepository
+	authClient authpb.AuthServiceClient
 }
 
-func NewUserServer(repo *repository.ProfileRepository) *UserServer {
-	return &UserServer{repo: repo}
+func NewUserServer(repo *repository.ProfileRepository, authClient authpb.AuthServiceClient) *UserServer {
+	return &UserServer{repo: repo, authClient: authClient}
 }
 
 func (s *UserServer) CreateProfile(ctx context.Context, req *userpb.CreateProfileRequest) (*userpb.CreateProfileResponse, error) {
@@ -35,14 +41,76 @@ func (s *UserServer) CreateProfile(ctx context.Context, req *userpb.CreateProfil
 		Email:    req.Email,
 		Username: req.Username,
 	}
+	if req.EmailVerified {
+		now := time.Now()
+		profile.EmailVerifiedAt = &now
+	}
 
-	if err := s.repo.Create(ctx, profile); err != nil {
+	if _, err := s.repo.Create(ctx, profile); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create profile: %v", err)
 	}
 
 	return &userpb.CreateProfileResponse{ProfileId: profile.ID.String()}, nil
 }
 
+func (s *UserServer) ListProfileIDs(ctx context.Context, req *userpb.ListProfileIDsRequest) (*userpb.ListProfileIDsResponse, error) {
+	after := uuid.Nil
+	if req.PageToken != "" {
+		var err error
+		if after, err = uuid.Parse(req.PageToken); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid page token")
+		}
+	}
+	limit := int(req.Limit)
+	if limit <= 0 || limit > 1000 {
+		limit = 1000
+	}
+
+	ids, err := s.repo.ListIDs(ctx, after, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list profiles")
+	}
+
+	res := &userpb.ListProfileIDsResponse{}
+	for _, id := range ids {
+		res.UserIds = append(res.UserIds, id.String())
+	}
+	if len(ids) == limit {
+		res.NextPageToken = ids[len(ids)-1].String()
+	}
+	return res, nil
+}
+
+func (s *UserServer) DeleteProfile(ctx context.Context, req *userpb.DeleteProfileRequest) (*userpb.DeleteProfileResponse, error) {
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	if err := s.repo.Delete(ctx, uid); err != nil {
+		return nil, status.Error(codes.Internal, "failed to delete profile")
+	}
+	return &userpb.DeleteProfileResponse{Success: true}, nil
+}
+
+func (s *UserServer) ExportUserData(ctx context.Context, req *userpb.ExportUserDataRequest) (*userpb.ExportUserDataResponse, error) {
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	export, err := s.repo.Export(ctx, uid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "profile not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to export profile")
+	}
+	data, err := json.Marshal(export)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to export profile")
+	}
+	return &userpb.ExportUserDataResponse{Data: data}, nil
+}
+
 func (s *UserServer) SyncEmail(ctx context.Context, req *userpb.SyncEmailRequest) (*userpb.SyncEmailResponse, error) {
 	uid, _ := uuid.Parse(req.UserId)
 	err := s.repo.UpdateEmail(ctx, uid, req.NewEmail)
@@ -52,6 +120,33 @@ func (s *UserServer) SyncEmail(ctx context.Context, req *userpb.SyncEmailRequest
 	return &userpb.SyncEmailResponse{Success: true}, nil
 }
 
+func (s *UserServer) SetEmailVerified(ctx context.Context, req *userpb.SetEmailVerifiedRequest) (*userpb.SetEmailVerifiedResponse, error) {
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	if err := s.repo.MarkEmailVerified(ctx, uid); err != nil {
+		return nil, status.Error(codes.Internal, "failed to mark email verified")
+	}
+	return &userpb.SetEmailVerifiedResponse{Success: true}, nil
+}
+
+func (s *UserServer) SetBanStatus(ctx context.Context, req *userpb.SetBanStatusRequest) (*userpb.SetBanStatusResponse, error) {
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	var until *time.Time
+	if req.Banned && req.BannedUntil > 0 {
+		t := time.Unix(req.BannedUntil, 0)
+		until = &t
+	}
+	if err := s.repo.SetBanStatus(ctx, uid, req.Banned, until); err != nil {
+		return nil, status.Error(codes.Internal, "failed to set ban status")
+	}
+	return &userpb.SetBanStatusResponse{Success: true}, nil
+}
+
 func (s *UserServer) UpdateProfile(ctx context.Context, req *userpb.UpdateProfileRequest) (*userpb.UpdateProfileResponse, error) {
 	uid, err := uuid.Parse(req.UserId)
 	if err != nil {
@@ -59,14 +154,23 @@ func (s *UserServer) UpdateProfile(ctx context.Context, req *userpb.UpdateProfil
 	}
 
 	// Валидация
-	if req.Username == "" {
+	if req.Username == "" && req.Locale == "" {
 		return nil, status.Error(codes.InvalidArgument, "username cannot be empty")
 	}
+	if req.Locale != "" && !domain.SupportedLocales[req.Locale] {
+		return nil, status.Error(codes.InvalidArgument, "unsupported locale")
+	}
 
 	// Вызываем репозиторий
-	err = s.repo.UpdateUsername(ctx, uid, req.Username)
-	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to update profile")
+	if req.Username != "" {
+		if err := s.repo.UpdateUsername(ctx, uid, req.Username); err != nil {
+			return nil, status.Error(codes.Internal, "failed to update profile")
+		}
+	}
+	if req.Locale != "" {
+		if err := s.repo.UpdateLocale(ctx, uid, req.Locale); err != nil {
+			return nil, status.Error(codes.Internal, "failed to update profile")
+		}
 	}
 
 	return &userpb.UpdateProfileResponse{Success: true}, nil
@@ -112,6 +216,10 @@ func (s *UserServer) UpdateProgress(ctx context.Context, req *userpb.UpdateProgr
 func (s *UserServer) GetProfile(ctx context.Context, req *userpb.GetProfileRequest) (*userpb.GetProfileResponse, error) {
 	uid, _ := uuid.Parse(req.UserId)
 	p, err := s.repo.GetByID(ctx, uid)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// Auth по этому коду понимает, что профиль нужно досоздать
+		return nil, status.Error(codes.NotFound, "profile not found")
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -144,8 +252,8 @@ func (s *UserServer) GetProfile(ctx context.Context, req *userpb.GetProfileReque
 	var unlockedIDs []int32
 
 	// =====> НОВАЯ ЛОГИКА ДЛЯ АДМИНА <=====
-	if p.SubscriptionStatus == "admin" {
-		// Если пользователь админ, открываем ему все 19 аватарок
+	if req.FullAccess {
+		// Право content:all (админ, контент-менеджер) открывает все 19 аватарок
 		for i := 1; i <= 19; i++ {
 			unlockedIDs = append(unlockedIDs, int32(i))
 		}
@@ -181,6 +289,9 @@ func (s *UserServer) GetProfile(ctx context.Context, req *userpb.GetProfileReque
 		Balance:             int32(p.Balance),
 		UnlockedAvatarIds:   unlockedIDs,
 		Rank:                int32(rank),
+		EmailVerified:       p.EmailVerifiedAt != nil,
+		Locale:              p.Locale,
+		Banned:              p.IsBanned(now),
 	}, nil
 }
 
@@ -210,10 +321,18 @@ func (s *UserServer) CompleteLesson(ctx context.Context, req *userpb.CompleteLes
 
 		// Начисляем +10 снежинок ТОЛЬКО если стрик был обновлен (первый урок за день)
 		if streakUpdated {
-			if newBalance, err := s.repo.ChangeBalance(ctx, uid, 10); err != nil {
+			// Ключ по дате: бонус за день начисляется один раз, даже если запросы пришли параллельно
+			day := time.Now().UTC().Format("2006-01-02")
+			if t, err := s.repo.ChangeBalance(ctx, domain.BalanceChange{
+				UserID:         uid,
+				Amount:         10,
+				Reason:         domain.ReasonDailyBonus,
+				ReferenceID:    day,
+				IdempotencyKey: domain.ReasonDailyBonus + ":" + day,
+			}); err != nil {
 				fmt.Printf("Error changing balance: %v\n", err)
 			} else {
-				fmt.Printf("User %s earned daily bonus. New balance: %d\n", uid, newBalance)
+				fmt.Printf("User %s earned daily bonus. New balance: %d\n", uid, t.BalanceAfter)
 			}
 		}
 	}
@@ -238,7 +357,15 @@ func (s *UserServer) CompleteLesson(ctx context.Context, req *userpb.CompleteLes
 		// Если курс еще не был помечен как "completed", значит это первое завершение
 		if currentStatus != "completed" {
 			// Выдаем большую награду
-			s.repo.ChangeBalance(ctx, uid, 50)
+			if _, err := s.repo.ChangeBalance(ctx, domain.BalanceChange{
+				UserID:         uid,
+				Amount:         50,
+				Reason:         domain.ReasonCourseCompletion,
+				ReferenceID:    req.CourseId,
+				IdempotencyKey: domain.ReasonCourseCompletion + ":" + req.CourseId,
+			}); err != nil {
+				fmt.Printf("Error changing balance: %v\n", err)
+			}
 			// Увеличиваем счетчик пройденных курсов для лидерборда
 			s.repo.IncrementCompletedCount(ctx, uid)
 		}
@@ -281,10 +408,25 @@ func (s *UserServer) SetSubscription(ctx context.Context, req *userpb.SetSubscri
 		"subscription_ends_at": time.Unix(req.ExpiresAt, 0),
 	}
 
+	old, oldErr := s.repo.GetByID(ctx, uid)
 	if err := s.repo.UpdateSubscription(ctx, uid, updates); err != nil {
 		return nil, status.Error(codes.Internal, "failed to update profile")
 	}
 
+	// Лимит понизили — лишние устройства отключаются сразу. Если Auth недоступен,
+	// подписку все равно меняем: Auth досчитает лимит при следующем входе.
+	if oldErr != nil || int(req.DeviceLimit) < old.DeviceLimit {
+		res, err := s.authClient.ReconcileDevices(ctx, &authpb.ReconcileDevicesRequest{
+			UserId:      req.UserId,
+			DeviceLimit: req.DeviceLimit,
+		})
+		if err != nil {
+			log.Printf("Failed to reconcile devices of %s: %v", req.UserId, err)
+		} else if res.Removed > 0 {
+			log.Printf("Subscription of %s changed: %d devices removed", req.UserId, res.Removed)
+		}
+	}
+
 	return &userpb.SetSubscriptionResponse{Success: true}, nil
 }
 
@@ -295,8 +437,8 @@ func (s *UserServer) StartCourse(ctx context.Context, req *userpb.StartCourseReq
 		return nil, status.Error(codes.NotFound, "profile not found")
 	}
 
-	// Логика доступа
-	if profile.SubscriptionStatus != "admin" {
+	// Логика доступа; право content:all проверки подписки не требует
+	if !req.FullAccess {
 
 		// А. Проверка срока действия
 		if profile.SubscriptionStatus != "Обычный" && time.Now().After(profile.SubscriptionEndsAt) {
@@ -334,15 +476,92 @@ func (s *UserServer) StartCourse(ctx context.Context, req *userpb.StartCourseReq
 }
 
 func (s *UserServer) AddCourseLimit(ctx context.Context, req *userpb.AddCourseLimitRequest) (*userpb.AddCourseLimitResponse, error) {
-	uid, _ := uuid.Parse(req.UserId)
-	err := s.repo.AddCourseSlots(ctx, uid, int(req.Count))
-	return &userpb.AddCourseLimitResponse{Success: err == nil}, err
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	err = s.repo.AddCourseSlots(ctx, uid, int(req.Count), req.IdempotencyKey)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "profile not found")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to add course slots")
+	}
+	return &userpb.AddCourseLimitResponse{Success: true}, nil
 }
 
 func (s *UserServer) ChangeBalance(ctx context.Context, req *userpb.ChangeBalanceRequest) (*userpb.ChangeBalanceResponse, error) {
-	uid, _ := uuid.Parse(req.UserId)
-	newBal, err := s.repo.ChangeBalance(ctx, uid, int(req.Amount))
-	return &userpb.ChangeBalanceResponse{Success: err == nil, NewBalance: int32(newBal)}, err
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	if req.Amount == 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must not be zero")
+	}
+
+	t, err := s.repo.ChangeBalance(ctx, domain.BalanceChange{
+		UserID:         uid,
+		Amount:         int(req.Amount),
+		Reason:         req.Reason,
+		ReferenceID:    req.ReferenceId,
+		IdempotencyKey: req.IdempotencyKey,
+	})
+	switch {
+	case errors.Is(err, domain.ErrUnknownReason), errors.Is(err, domain.ErrIdempotencyKeyReused):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrInsufficientFunds):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, status.Error(codes.NotFound, "profile not found")
+	case err != nil:
+		return nil, status.Error(codes.Internal, "failed to change balance")
+	}
+	return &userpb.ChangeBalanceResponse{
+		Success:       true,
+		NewBalance:    int32(t.BalanceAfter),
+		TransactionId: uint64(t.ID),
+	}, nil
+}
+
+// Сколько операций по балансу отдаем за раз
+const (
+	balanceHistoryDefaultLimit = 50
+	balanceHistoryMaxLimit     = 200
+)
+
+func (s *UserServer) GetBalanceHistory(ctx context.Context, req *userpb.GetBalanceHistoryRequest) (*userpb.GetBalanceHistoryResponse, error) {
+	uid, err := uuid.Parse(req.UserId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = balanceHistoryDefaultLimit
+	}
+	if limit > balanceHistoryMaxLimit {
+		limit = balanceHistoryMaxLimit
+	}
+
+	items, err := s.repo.BalanceHistory(ctx, uid, limit, uint(req.BeforeId))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to load balance history")
+	}
+
+	res := &userpb.GetBalanceHistoryResponse{Entries: make([]*userpb.BalanceEntry, 0, len(items))}
+	for _, t := range items {
+		res.Entries = append(res.Entries, &userpb.BalanceEntry{
+			Id:           uint64(t.ID),
+			Amount:       int32(t.Amount),
+			BalanceAfter: int32(t.BalanceAfter),
+			Reason:       t.Reason,
+			ReferenceId:  t.ReferenceID,
+			CreatedAt:    t.CreatedAt.Unix(),
+		})
+	}
+	if len(items) == limit {
+		res.NextBeforeId = uint64(items[len(items)-1].ID)
+	}
+	return res, nil
 }
 
 func (s *UserServer) UnlockAvatar(ctx context.Context, req *userpb.UnlockAvatarRequest) (*userpb.UnlockAvatarResponse, error) {